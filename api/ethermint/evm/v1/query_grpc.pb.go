@@ -36,6 +36,7 @@ const (
 	Query_BaseFee_FullMethodName           = "/ethermint.evm.v1.Query/BaseFee"
 	Query_GlobalMinGasPrice_FullMethodName = "/ethermint.evm.v1.Query/GlobalMinGasPrice"
 	Query_Config_FullMethodName            = "/ethermint.evm.v1.Query/Config"
+	Query_StaticPrecompiles_FullMethodName = "/ethermint.evm.v1.Query/StaticPrecompiles"
 )
 
 // QueryClient is the client API for Query service.
@@ -76,6 +77,8 @@ type QueryClient interface {
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	// StaticPrecompiles queries the information of the available static precompiles
+	StaticPrecompiles(ctx context.Context, in *QueryStaticPrecompilesRequest, opts ...grpc.CallOption) (*QueryStaticPrecompilesResponse, error)
 }
 
 type queryClient struct {
@@ -212,6 +215,15 @@ func (c *queryClient) Config(ctx context.Context, in *QueryConfigRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) StaticPrecompiles(ctx context.Context, in *QueryStaticPrecompilesRequest, opts ...grpc.CallOption) (*QueryStaticPrecompilesResponse, error) {
+	out := new(QueryStaticPrecompilesResponse)
+	err := c.cc.Invoke(ctx, Query_StaticPrecompiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -250,6 +262,8 @@ type QueryServer interface {
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
+	// StaticPrecompiles queries the information of the available static precompiles
+	StaticPrecompiles(context.Context, *QueryStaticPrecompilesRequest) (*QueryStaticPrecompilesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -299,6 +313,9 @@ func (UnimplementedQueryServer) GlobalMinGasPrice(context.Context, *QueryGlobalM
 func (UnimplementedQueryServer) Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
+func (UnimplementedQueryServer) StaticPrecompiles(context.Context, *QueryStaticPrecompilesRequest) (*QueryStaticPrecompilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StaticPrecompiles not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -564,6 +581,24 @@ func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StaticPrecompiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStaticPrecompilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StaticPrecompiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StaticPrecompiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StaticPrecompiles(ctx, req.(*QueryStaticPrecompilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -627,6 +662,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Config",
 			Handler:    _Query_Config_Handler,
 		},
+		{
+			MethodName: "StaticPrecompiles",
+			Handler:    _Query_StaticPrecompiles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/query.proto",
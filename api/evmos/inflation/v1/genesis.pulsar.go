@@ -663,6 +663,7 @@ var (
 	fd_Params_exponential_calculation protoreflect.FieldDescriptor
 	fd_Params_inflation_distribution  protoreflect.FieldDescriptor
 	fd_Params_enable_inflation        protoreflect.FieldDescriptor
+	fd_Params_dust_destination        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -672,6 +673,7 @@ func init() {
 	fd_Params_exponential_calculation = md_Params.Fields().ByName("exponential_calculation")
 	fd_Params_inflation_distribution = md_Params.Fields().ByName("inflation_distribution")
 	fd_Params_enable_inflation = md_Params.Fields().ByName("enable_inflation")
+	fd_Params_dust_destination = md_Params.Fields().ByName("dust_destination")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -763,6 +765,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.DustDestination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DustDestination))
+		if !f(fd_Params_dust_destination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -786,6 +794,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.InflationDistribution != nil
 	case "evmos.inflation.v1.Params.enable_inflation":
 		return x.EnableInflation != false
+	case "evmos.inflation.v1.Params.dust_destination":
+		return x.DustDestination != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -810,6 +820,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.InflationDistribution = nil
 	case "evmos.inflation.v1.Params.enable_inflation":
 		x.EnableInflation = false
+	case "evmos.inflation.v1.Params.dust_destination":
+		x.DustDestination = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -838,6 +850,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "evmos.inflation.v1.Params.enable_inflation":
 		value := x.EnableInflation
 		return protoreflect.ValueOfBool(value)
+	case "evmos.inflation.v1.Params.dust_destination":
+		value := x.DustDestination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -866,6 +881,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.InflationDistribution = value.Message().Interface().(*InflationDistribution)
 	case "evmos.inflation.v1.Params.enable_inflation":
 		x.EnableInflation = value.Bool()
+	case "evmos.inflation.v1.Params.dust_destination":
+		x.DustDestination = (DustDestination)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -900,6 +917,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field mint_denom of message evmos.inflation.v1.Params is not mutable"))
 	case "evmos.inflation.v1.Params.enable_inflation":
 		panic(fmt.Errorf("field enable_inflation of message evmos.inflation.v1.Params is not mutable"))
+	case "evmos.inflation.v1.Params.dust_destination":
+		panic(fmt.Errorf("field dust_destination of message evmos.inflation.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -923,6 +942,8 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "evmos.inflation.v1.Params.enable_inflation":
 		return protoreflect.ValueOfBool(false)
+	case "evmos.inflation.v1.Params.dust_destination":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.inflation.v1.Params"))
@@ -1007,6 +1028,9 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.EnableInflation {
 			n += 2
 		}
+		if x.DustDestination != 0 {
+			n += 1 + runtime.Sov(uint64(x.DustDestination))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1036,6 +1060,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DustDestination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DustDestination))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.EnableInflation {
 			i--
 			if x.EnableInflation {
@@ -1254,6 +1283,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.EnableInflation = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustDestination", wireType)
+				}
+				x.DustDestination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DustDestination |= DustDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1392,6 +1440,8 @@ type Params struct {
 	InflationDistribution *InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution,omitempty"`
 	// enable_inflation is the parameter that enables inflation and halts increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,4,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// dust_destination defines where the remainder of the truncated allocations is sent
+	DustDestination DustDestination `protobuf:"varint,5,opt,name=dust_destination,json=dustDestination,proto3,enum=evmos.inflation.v1.DustDestination" json:"dust_destination,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1442,6 +1492,13 @@ func (x *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (x *Params) GetDustDestination() DustDestination {
+	if x != nil {
+		return x.DustDestination
+	}
+	return DustDestination_DUST_DESTINATION_COMMUNITY_POOL
+}
+
 var File_evmos_inflation_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_evmos_inflation_v1_genesis_proto_rawDesc = []byte{
@@ -1467,7 +1524,7 @@ var file_evmos_inflation_v1_genesis_proto_rawDesc = []byte{
 	0x03, 0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69,
 	0x6f, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x70,
 	0x6f, 0x63, 0x68, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70,
-	0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x06, 0x50, 0x61,
+	0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xff, 0x02, 0x0a, 0x06, 0x50, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e,
 	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44, 0x65,
 	0x6e, 0x6f, 0x6d, 0x12, 0x6e, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69,
@@ -1486,7 +1543,12 @@ var file_evmos_inflation_v1_genesis_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xc1, 0x01, 0x0a, 0x16,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x10, 0x64,
+	0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x73, 0x74, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x64, 0x75, 0x73, 0x74,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xc1, 0x01, 0x0a, 0x16,
 	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
 	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
@@ -1520,16 +1582,18 @@ var file_evmos_inflation_v1_genesis_proto_goTypes = []interface{}{
 	(*Params)(nil),                 // 1: evmos.inflation.v1.Params
 	(*ExponentialCalculation)(nil), // 2: evmos.inflation.v1.ExponentialCalculation
 	(*InflationDistribution)(nil),  // 3: evmos.inflation.v1.InflationDistribution
+	(DustDestination)(0),           // 4: evmos.inflation.v1.DustDestination
 }
 var file_evmos_inflation_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: evmos.inflation.v1.GenesisState.params:type_name -> evmos.inflation.v1.Params
 	2, // 1: evmos.inflation.v1.Params.exponential_calculation:type_name -> evmos.inflation.v1.ExponentialCalculation
 	3, // 2: evmos.inflation.v1.Params.inflation_distribution:type_name -> evmos.inflation.v1.InflationDistribution
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: evmos.inflation.v1.Params.dust_destination:type_name -> evmos.inflation.v1.DustDestination
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_evmos_inflation_v1_genesis_proto_init() }
@@ -1254,6 +1254,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DustDestination defines where the remainder (dust) left by the truncation of
+// the inflation allocations is sent.
+type DustDestination int32
+
+const (
+	// DUST_DESTINATION_COMMUNITY_POOL sends the dust to the community pool.
+	DustDestination_DUST_DESTINATION_COMMUNITY_POOL DustDestination = 0
+	// DUST_DESTINATION_STAKING_REWARDS adds the dust to the staking rewards.
+	DustDestination_DUST_DESTINATION_STAKING_REWARDS DustDestination = 1
+)
+
+// Enum value maps for DustDestination.
+var (
+	DustDestination_name = map[int32]string{
+		0: "DUST_DESTINATION_COMMUNITY_POOL",
+		1: "DUST_DESTINATION_STAKING_REWARDS",
+	}
+	DustDestination_value = map[string]int32{
+		"DUST_DESTINATION_COMMUNITY_POOL":  0,
+		"DUST_DESTINATION_STAKING_REWARDS": 1,
+	}
+)
+
+func (x DustDestination) Enum() *DustDestination {
+	p := new(DustDestination)
+	*p = x
+	return p
+}
+
+func (x DustDestination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DustDestination) Descriptor() protoreflect.EnumDescriptor {
+	return file_evmos_inflation_v1_inflation_proto_enumTypes[0].Descriptor()
+}
+
+func (DustDestination) Type() protoreflect.EnumType {
+	return &file_evmos_inflation_v1_inflation_proto_enumTypes[0]
+}
+
+func (x DustDestination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DustDestination.Descriptor instead.
+func (DustDestination) EnumDescriptor() ([]byte, []int) {
+	return file_evmos_inflation_v1_inflation_proto_rawDescGZIP(), []int{0}
+}
+
 // InflationDistribution defines the distribution in which inflation is
 // allocated through minting on each epoch (staking, incentives, community). It
 // excludes the team vesting distribution, as this is minted once at genesis.
@@ -1415,10 +1465,10 @@ var file_evmos_inflation_v1_inflation_proto_rawDesc = []byte{
 	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e,
 	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x55,
 	0x0a, 0x10, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x18, 0x01, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x63, 0x65, 0x6e,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x18, 0x01, 0x52, 0x0f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x63, 0x65, 0x6e,
 	0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
 	0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8,
 	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
@@ -1446,20 +1496,30 @@ var file_evmos_inflation_v1_inflation_proto_rawDesc = []byte{
 	0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
 	0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x42, 0xc3, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e,
-	0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x49, 0x58, 0xaa, 0x02, 0x12, 0x45, 0x76,
-	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e,
-	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
-	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x2a, 0xa7, 0x01, 0x0a, 0x0f, 0x44, 0x75, 0x73, 0x74,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x1f, 0x44,
+	0x55, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x00,
+	0x1a, 0x20, 0x8a, 0x9d, 0x20, 0x1c, 0x44, 0x75, 0x73, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f,
+	0x6f, 0x6c, 0x12, 0x47, 0x0a, 0x20, 0x44, 0x55, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49,
+	0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52,
+	0x45, 0x57, 0x41, 0x52, 0x44, 0x53, 0x10, 0x01, 0x1a, 0x21, 0x8a, 0x9d, 0x20, 0x1d, 0x44, 0x75,
+	0x73, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
+	0x00, 0x42, 0xc3, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x45, 0x49, 0x58, 0xaa, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x45, 0x76, 0x6d,
+	0x6f, 0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1e, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x14, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1474,10 +1534,12 @@ func file_evmos_inflation_v1_inflation_proto_rawDescGZIP() []byte {
 	return file_evmos_inflation_v1_inflation_proto_rawDescData
 }
 
+var file_evmos_inflation_v1_inflation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_evmos_inflation_v1_inflation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_evmos_inflation_v1_inflation_proto_goTypes = []interface{}{
-	(*InflationDistribution)(nil),  // 0: evmos.inflation.v1.InflationDistribution
-	(*ExponentialCalculation)(nil), // 1: evmos.inflation.v1.ExponentialCalculation
+	(DustDestination)(0),           // 0: evmos.inflation.v1.DustDestination
+	(*InflationDistribution)(nil),  // 1: evmos.inflation.v1.InflationDistribution
+	(*ExponentialCalculation)(nil), // 2: evmos.inflation.v1.ExponentialCalculation
 }
 var file_evmos_inflation_v1_inflation_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -1523,13 +1585,14 @@ func file_evmos_inflation_v1_inflation_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_evmos_inflation_v1_inflation_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_evmos_inflation_v1_inflation_proto_goTypes,
 		DependencyIndexes: file_evmos_inflation_v1_inflation_proto_depIdxs,
+		EnumInfos:         file_evmos_inflation_v1_inflation_proto_enumTypes,
 		MessageInfos:      file_evmos_inflation_v1_inflation_proto_msgTypes,
 	}.Build()
 	File_evmos_inflation_v1_inflation_proto = out.File
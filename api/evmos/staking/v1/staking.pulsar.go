@@ -0,0 +1,709 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package stakingv1
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ValidatorSetParams                       protoreflect.MessageDescriptor
+	fd_ValidatorSetParams_max_power_percent     protoreflect.FieldDescriptor
+	fd_ValidatorSetParams_target_max_validators protoreflect.FieldDescriptor
+	fd_ValidatorSetParams_max_validators_step   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_evmos_staking_v1_staking_proto_init()
+	md_ValidatorSetParams = File_evmos_staking_v1_staking_proto.Messages().ByName("ValidatorSetParams")
+	fd_ValidatorSetParams_max_power_percent = md_ValidatorSetParams.Fields().ByName("max_power_percent")
+	fd_ValidatorSetParams_target_max_validators = md_ValidatorSetParams.Fields().ByName("target_max_validators")
+	fd_ValidatorSetParams_max_validators_step = md_ValidatorSetParams.Fields().ByName("max_validators_step")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorSetParams)(nil)
+
+type fastReflection_ValidatorSetParams ValidatorSetParams
+
+func (x *ValidatorSetParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorSetParams)(x)
+}
+
+func (x *ValidatorSetParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_evmos_staking_v1_staking_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorSetParams_messageType fastReflection_ValidatorSetParams_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorSetParams_messageType{}
+
+type fastReflection_ValidatorSetParams_messageType struct{}
+
+func (x fastReflection_ValidatorSetParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorSetParams)(nil)
+}
+func (x fastReflection_ValidatorSetParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSetParams)
+}
+func (x fastReflection_ValidatorSetParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSetParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorSetParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSetParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorSetParams) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorSetParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorSetParams) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSetParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorSetParams) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorSetParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorSetParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxPowerPercent != "" {
+		value := protoreflect.ValueOfString(x.MaxPowerPercent)
+		if !f(fd_ValidatorSetParams_max_power_percent, value) {
+			return
+		}
+	}
+	if x.TargetMaxValidators != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TargetMaxValidators)
+		if !f(fd_ValidatorSetParams_target_max_validators, value) {
+			return
+		}
+	}
+	if x.MaxValidatorsStep != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxValidatorsStep)
+		if !f(fd_ValidatorSetParams_max_validators_step, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorSetParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evmos.staking.v1.ValidatorSetParams.max_power_percent":
+		return x.MaxPowerPercent != ""
+	case "evmos.staking.v1.ValidatorSetParams.target_max_validators":
+		return x.TargetMaxValidators != uint32(0)
+	case "evmos.staking.v1.ValidatorSetParams.max_validators_step":
+		return x.MaxValidatorsStep != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.staking.v1.ValidatorSetParams"))
+		}
+		panic(fmt.Errorf("message evmos.staking.v1.ValidatorSetParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSetParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evmos.staking.v1.ValidatorSetParams.max_power_percent":
+		x.MaxPowerPercent = ""
+	case "evmos.staking.v1.ValidatorSetParams.target_max_validators":
+		x.TargetMaxValidators = uint32(0)
+	case "evmos.staking.v1.ValidatorSetParams.max_validators_step":
+		x.MaxValidatorsStep = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.staking.v1.ValidatorSetParams"))
+		}
+		panic(fmt.Errorf("message evmos.staking.v1.ValidatorSetParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorSetParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evmos.staking.v1.ValidatorSetParams.max_power_percent":
+		value := x.MaxPowerPercent
+		return protoreflect.ValueOfString(value)
+	case "evmos.staking.v1.ValidatorSetParams.target_max_validators":
+		value := x.TargetMaxValidators
+		return protoreflect.ValueOfUint32(value)
+	case "evmos.staking.v1.ValidatorSetParams.max_validators_step":
+		value := x.MaxValidatorsStep
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.staking.v1.ValidatorSetParams"))
+		}
+		panic(fmt.Errorf("message evmos.staking.v1.ValidatorSetParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSetParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evmos.staking.v1.ValidatorSetParams.max_power_percent":
+		x.MaxPowerPercent = value.Interface().(string)
+	case "evmos.staking.v1.ValidatorSetParams.target_max_validators":
+		x.TargetMaxValidators = uint32(value.Uint())
+	case "evmos.staking.v1.ValidatorSetParams.max_validators_step":
+		x.MaxValidatorsStep = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.staking.v1.ValidatorSetParams"))
+		}
+		panic(fmt.Errorf("message evmos.staking.v1.ValidatorSetParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSetParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.staking.v1.ValidatorSetParams.max_power_percent":
+		panic(fmt.Errorf("field max_power_percent of message evmos.staking.v1.ValidatorSetParams is not mutable"))
+	case "evmos.staking.v1.ValidatorSetParams.target_max_validators":
+		panic(fmt.Errorf("field target_max_validators of message evmos.staking.v1.ValidatorSetParams is not mutable"))
+	case "evmos.staking.v1.ValidatorSetParams.max_validators_step":
+		panic(fmt.Errorf("field max_validators_step of message evmos.staking.v1.ValidatorSetParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.staking.v1.ValidatorSetParams"))
+		}
+		panic(fmt.Errorf("message evmos.staking.v1.ValidatorSetParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorSetParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.staking.v1.ValidatorSetParams.max_power_percent":
+		return protoreflect.ValueOfString("")
+	case "evmos.staking.v1.ValidatorSetParams.target_max_validators":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "evmos.staking.v1.ValidatorSetParams.max_validators_step":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.staking.v1.ValidatorSetParams"))
+		}
+		panic(fmt.Errorf("message evmos.staking.v1.ValidatorSetParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorSetParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evmos.staking.v1.ValidatorSetParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorSetParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorSetParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorSetParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorSetParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorSetParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MaxPowerPercent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TargetMaxValidators != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetMaxValidators))
+		}
+		if x.MaxValidatorsStep != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxValidatorsStep))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorSetParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxValidatorsStep != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxValidatorsStep))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.TargetMaxValidators != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetMaxValidators))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.MaxPowerPercent) > 0 {
+			i -= len(x.MaxPowerPercent)
+			copy(dAtA[i:], x.MaxPowerPercent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPowerPercent)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorSetParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSetParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPowerPercent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPowerPercent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetMaxValidators", wireType)
+				}
+				x.TargetMaxValidators = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetMaxValidators |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorsStep", wireType)
+				}
+				x.MaxValidatorsStep = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxValidatorsStep |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: evmos/staking/v1/staking.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ValidatorSetParams defines the emergency parameters of the validator set
+// enforced by the staking wrapper on top of the Cosmos SDK staking params.
+type ValidatorSetParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_power_percent is the maximum share of the total consensus power that a
+	// single validator can hold. Stake above the cap remains delegated, but
+	// doesn't add consensus power. A zero value disables the cap. If there are
+	// fewer than 1/max_power_percent bonded validators, the cap can't be honored
+	// and all the validators get the same power instead.
+	MaxPowerPercent string `protobuf:"bytes,1,opt,name=max_power_percent,json=maxPowerPercent,proto3" json:"max_power_percent,omitempty"`
+	// target_max_validators is the maximum number of validators the staking
+	// MaxValidators param is moved towards. A zero value disables the adjustment.
+	TargetMaxValidators uint32 `protobuf:"varint,2,opt,name=target_max_validators,json=targetMaxValidators,proto3" json:"target_max_validators,omitempty"`
+	// max_validators_step is the maximum change of the staking MaxValidators param
+	// per block while moving towards the target. A zero value applies the target
+	// at once.
+	MaxValidatorsStep uint32 `protobuf:"varint,3,opt,name=max_validators_step,json=maxValidatorsStep,proto3" json:"max_validators_step,omitempty"`
+}
+
+func (x *ValidatorSetParams) Reset() {
+	*x = ValidatorSetParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmos_staking_v1_staking_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorSetParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSetParams) ProtoMessage() {}
+
+// Deprecated: Use ValidatorSetParams.ProtoReflect.Descriptor instead.
+func (*ValidatorSetParams) Descriptor() ([]byte, []int) {
+	return file_evmos_staking_v1_staking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorSetParams) GetMaxPowerPercent() string {
+	if x != nil {
+		return x.MaxPowerPercent
+	}
+	return ""
+}
+
+func (x *ValidatorSetParams) GetTargetMaxValidators() uint32 {
+	if x != nil {
+		return x.TargetMaxValidators
+	}
+	return 0
+}
+
+func (x *ValidatorSetParams) GetMaxValidatorsStep() uint32 {
+	if x != nil {
+		return x.MaxValidatorsStep
+	}
+	return 0
+}
+
+var File_evmos_staking_v1_staking_proto protoreflect.FileDescriptor
+
+var file_evmos_staking_v1_staking_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x10, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x01, 0x0a, 0x12,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x54, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d,
+	0x61, 0x78, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x73,
+	0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x74, 0x65, 0x70, 0x42, 0xb3, 0x01, 0x0a,
+	0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x53, 0x58, 0xaa, 0x02, 0x10, 0x45, 0x76, 0x6d, 0x6f, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x45, 0x76,
+	0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1c, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12,
+	0x45, 0x76, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_evmos_staking_v1_staking_proto_rawDescOnce sync.Once
+	file_evmos_staking_v1_staking_proto_rawDescData = file_evmos_staking_v1_staking_proto_rawDesc
+)
+
+func file_evmos_staking_v1_staking_proto_rawDescGZIP() []byte {
+	file_evmos_staking_v1_staking_proto_rawDescOnce.Do(func() {
+		file_evmos_staking_v1_staking_proto_rawDescData = protoimpl.X.CompressGZIP(file_evmos_staking_v1_staking_proto_rawDescData)
+	})
+	return file_evmos_staking_v1_staking_proto_rawDescData
+}
+
+var file_evmos_staking_v1_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_evmos_staking_v1_staking_proto_goTypes = []interface{}{
+	(*ValidatorSetParams)(nil), // 0: evmos.staking.v1.ValidatorSetParams
+}
+var file_evmos_staking_v1_staking_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_evmos_staking_v1_staking_proto_init() }
+func file_evmos_staking_v1_staking_proto_init() {
+	if File_evmos_staking_v1_staking_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_evmos_staking_v1_staking_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorSetParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_evmos_staking_v1_staking_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_evmos_staking_v1_staking_proto_goTypes,
+		DependencyIndexes: file_evmos_staking_v1_staking_proto_depIdxs,
+		MessageInfos:      file_evmos_staking_v1_staking_proto_msgTypes,
+	}.Build()
+	File_evmos_staking_v1_staking_proto = out.File
+	file_evmos_staking_v1_staking_proto_rawDesc = nil
+	file_evmos_staking_v1_staking_proto_goTypes = nil
+	file_evmos_staking_v1_staking_proto_depIdxs = nil
+}
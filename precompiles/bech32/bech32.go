@@ -50,6 +50,11 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress(evmtypes.Bech32PrecompileAddress)
 }
 
+// ABIHash returns the hash of the bech32 precompile ABI.
+func (p Precompile) ABIHash() common.Hash {
+	return cmn.ABIHash(p.ABI)
+}
+
 // RequiredGas calculates the contract gas use.
 func (p Precompile) RequiredGas(_ []byte) uint64 {
 	return p.baseGas
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -145,3 +147,22 @@ func LoadABI(fs embed.FS, path string) (abi.ABI, error) {
 
 	return contract.ABI, nil
 }
+
+// ABIHash returns a deterministic hash of the given ABI. It is computed over the
+// sorted string representation of the ABI methods, events and errors, so it only
+// changes when the interface of the contract changes.
+func ABIHash(contractABI abi.ABI) common.Hash {
+	entries := make([]string, 0, len(contractABI.Methods)+len(contractABI.Events)+len(contractABI.Errors))
+	for _, method := range contractABI.Methods {
+		entries = append(entries, method.String())
+	}
+	for _, event := range contractABI.Events {
+		entries = append(entries, event.String())
+	}
+	for _, abiErr := range contractABI.Errors {
+		entries = append(entries, abiErr.String())
+	}
+	sort.Strings(entries)
+
+	return crypto.Keccak256Hash([]byte(strings.Join(entries, "\n")))
+}
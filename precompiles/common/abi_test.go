@@ -0,0 +1,44 @@
+package common_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	transferEntry = `{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable"}`
+	balanceEntry  = `{"type":"function","name":"balanceOf","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"}`
+	eventEntry    = `{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}],"anonymous":false}`
+	approveEntry  = `{"type":"function","name":"approve","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable"}`
+)
+
+func parseABI(t *testing.T, entries ...string) abi.ABI {
+	t.Helper()
+	contractABI, err := abi.JSON(strings.NewReader("[" + strings.Join(entries, ",") + "]"))
+	require.NoError(t, err)
+	return contractABI
+}
+
+func TestABIHash(t *testing.T) {
+	contractABI := parseABI(t, transferEntry, balanceEntry, eventEntry)
+	hash := common.ABIHash(contractABI)
+	require.NotEqual(t, [32]byte{}, [32]byte(hash))
+
+	// the hash is stable across calls
+	require.Equal(t, hash, common.ABIHash(contractABI))
+
+	// the hash does not depend on the order of the ABI entries
+	reordered := parseABI(t, eventEntry, balanceEntry, transferEntry)
+	require.Equal(t, hash, common.ABIHash(reordered))
+
+	// the hash changes when the interface changes
+	extended := parseABI(t, transferEntry, balanceEntry, eventEntry, approveEntry)
+	require.NotEqual(t, hash, common.ABIHash(extended))
+
+	withoutEvent := parseABI(t, transferEntry, balanceEntry)
+	require.NotEqual(t, hash, common.ABIHash(withoutEvent))
+}
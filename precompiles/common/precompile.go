@@ -55,6 +55,11 @@ type snapshot struct {
 	Events     sdk.Events
 }
 
+// ABIHash returns the hash of the precompile ABI.
+func (p Precompile) ABIHash() common.Hash {
+	return ABIHash(p.ABI)
+}
+
 // RequiredGas calculates the base minimum required gas for a transaction or a query.
 // It uses the method ID to determine if the input is a transaction or a query and
 // uses the Cosmos SDK gas config flat cost and the flat per byte cost * len(argBz) to calculate the gas.
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IRegistry contract's address.
+address constant IREGISTRY_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+/// @dev The IRegistry contract's instance.
+IRegistry constant IREGISTRY_CONTRACT = IRegistry(IREGISTRY_PRECOMPILE_ADDRESS);
+
+/// @dev PrecompileInfo specifies the information of a static precompile.
+struct PrecompileInfo {
+    /// precompileAddress defines the address of the precompile.
+    address precompileAddress;
+    /// name defines the name of the precompile.
+    string name;
+    /// version defines the version of the precompile interface.
+    uint64 version;
+    /// abiHash defines the hash of the precompile ABI. It is empty for the
+    /// precompiles without an ABI.
+    bytes32 abiHash;
+    /// enabled is true if the precompile is enabled at the current height.
+    bool enabled;
+}
+
+/**
+ * @author Evmos Team
+ * @title Registry Interface
+ * @dev Interface for querying the static precompiles available on the chain
+ * and whether they are enabled at the current height.
+ */
+interface IRegistry {
+    /// @dev isEnabled defines a method for checking if a precompile is enabled
+    /// at the current height.
+    /// @param precompile the address of the precompile.
+    /// @return enabled true if the precompile is enabled.
+    function isEnabled(address precompile) external view returns (bool enabled);
+
+    /// @dev getPrecompile defines a method for retrieving the information of a
+    /// static precompile. An empty info is returned for unknown addresses.
+    /// @param precompile the address of the precompile.
+    /// @return info the information of the precompile.
+    function getPrecompile(
+        address precompile
+    ) external view returns (PrecompileInfo memory info);
+
+    /// @dev getPrecompiles defines a method for retrieving the information of
+    /// all the available static precompiles.
+    /// @return precompiles the information of the precompiles.
+    function getPrecompiles()
+        external
+        view
+        returns (PrecompileInfo[] memory precompiles);
+}
@@ -0,0 +1,85 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package registry
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+const (
+	// IsEnabledMethod defines the ABI method name for the registry IsEnabled
+	// query.
+	IsEnabledMethod = "isEnabled"
+	// GetPrecompileMethod defines the ABI method name for the registry
+	// GetPrecompile query.
+	GetPrecompileMethod = "getPrecompile"
+	// GetPrecompilesMethod defines the ABI method name for the registry
+	// GetPrecompiles query.
+	GetPrecompilesMethod = "getPrecompiles"
+)
+
+// IsEnabled returns true if the given static precompile is enabled at the
+// current height.
+func (p Precompile) IsEnabled(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	precompile, err := ParsePrecompileArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("error calling isEnabled in registry precompile: %s", err)
+	}
+
+	for _, info := range p.evmKeeper.GetStaticPrecompilesInfo(ctx) {
+		if info.Address == precompile {
+			return method.Outputs.Pack(info.Enabled)
+		}
+	}
+
+	return method.Outputs.Pack(false)
+}
+
+// GetPrecompile returns the information of the given static precompile. An
+// empty info is returned if the address is not a static precompile.
+func (p Precompile) GetPrecompile(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	precompile, err := ParsePrecompileArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("error calling getPrecompile in registry precompile: %s", err)
+	}
+
+	for _, info := range p.evmKeeper.GetStaticPrecompilesInfo(ctx) {
+		if info.Address == precompile {
+			return method.Outputs.Pack(NewPrecompileInfo(info))
+		}
+	}
+
+	return method.Outputs.Pack(PrecompileInfo{})
+}
+
+// GetPrecompiles returns the information of all the available static precompiles.
+func (p Precompile) GetPrecompiles(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	infos := p.evmKeeper.GetStaticPrecompilesInfo(ctx)
+	precompiles := make([]PrecompileInfo, len(infos))
+	for i, info := range infos {
+		precompiles[i] = NewPrecompileInfo(info)
+	}
+
+	return method.Outputs.Pack(precompiles)
+}
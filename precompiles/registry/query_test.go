@@ -0,0 +1,275 @@
+package registry_test
+
+import (
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/registry"
+	evmosutiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// disableGovPrecompile removes the gov precompile from the active static precompiles.
+func (s *PrecompileTestSuite) disableGovPrecompile() {
+	ctx := s.network.GetContext()
+	params := s.network.App.EvmKeeper.GetParams(ctx)
+	params.ActiveStaticPrecompiles = slices.DeleteFunc(params.ActiveStaticPrecompiles, func(address string) bool {
+		return address == evmtypes.GovPrecompileAddress
+	})
+	s.Require().NoError(s.network.App.EvmKeeper.SetParams(ctx, params))
+}
+
+func (s *PrecompileTestSuite) TestIsEnabled() {
+	method := s.precompile.Methods[registry.IsEnabledMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expEnabled  bool
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			"invalid number of arguments",
+			false,
+		},
+		{
+			"fail - invalid precompile address",
+			func() []interface{} {
+				return []interface{}{"random text"}
+			},
+			false,
+			"invalid type for precompile",
+			false,
+		},
+		{
+			"pass - enabled precompile",
+			func() []interface{} {
+				return []interface{}{common.HexToAddress(evmtypes.GovPrecompileAddress)}
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"pass - disabled precompile",
+			func() []interface{} {
+				s.disableGovPrecompile()
+				return []interface{}{common.HexToAddress(evmtypes.GovPrecompileAddress)}
+			},
+			true,
+			"",
+			false,
+		},
+		{
+			"pass - unknown address",
+			func() []interface{} {
+				return []interface{}{evmosutiltx.GenerateAddress()}
+			},
+			true,
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.IsEnabled(s.network.GetContext(), &method, tc.malleate())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var enabled bool
+				err = s.precompile.UnpackIntoInterface(&enabled, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expEnabled, enabled)
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetPrecompile() {
+	method := s.precompile.Methods[registry.GetPrecompileMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expInfo     func() registry.PrecompileInfo
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"pass - enabled precompile",
+			func() []interface{} {
+				return []interface{}{s.precompile.Address()}
+			},
+			true,
+			"",
+			func() registry.PrecompileInfo {
+				return registry.PrecompileInfo{
+					PrecompileAddress: s.precompile.Address(),
+					Name:              "registry",
+					Version:           1,
+					AbiHash:           s.precompile.ABIHash(),
+					Enabled:           true,
+				}
+			},
+		},
+		{
+			"pass - disabled precompile",
+			func() []interface{} {
+				s.disableGovPrecompile()
+				return []interface{}{common.HexToAddress(evmtypes.GovPrecompileAddress)}
+			},
+			true,
+			"",
+			func() registry.PrecompileInfo {
+				gov, found, err := s.network.App.EvmKeeper.GetStaticPrecompileInstance(
+					&evmtypes.Params{ActiveStaticPrecompiles: []string{evmtypes.GovPrecompileAddress}},
+					common.HexToAddress(evmtypes.GovPrecompileAddress),
+				)
+				s.Require().NoError(err)
+				s.Require().True(found)
+
+				return registry.PrecompileInfo{
+					PrecompileAddress: common.HexToAddress(evmtypes.GovPrecompileAddress),
+					Name:              "gov",
+					Version:           1,
+					AbiHash:           gov.(interface{ ABIHash() common.Hash }).ABIHash(),
+					Enabled:           false,
+				}
+			},
+		},
+		{
+			"pass - precompile without ABI",
+			func() []interface{} {
+				return []interface{}{common.HexToAddress(evmtypes.P256PrecompileAddress)}
+			},
+			true,
+			"",
+			func() registry.PrecompileInfo {
+				return registry.PrecompileInfo{
+					PrecompileAddress: common.HexToAddress(evmtypes.P256PrecompileAddress),
+					Name:              "p256",
+					Version:           1,
+					Enabled:           true,
+				}
+			},
+		},
+		{
+			"pass - empty info for an unknown address",
+			func() []interface{} {
+				return []interface{}{evmosutiltx.GenerateAddress()}
+			},
+			true,
+			"",
+			func() registry.PrecompileInfo {
+				return registry.PrecompileInfo{}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.GetPrecompile(s.network.GetContext(), &method, tc.malleate())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var out struct{ Precompile registry.PrecompileInfo }
+				err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expInfo(), out.Precompile)
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetPrecompiles() {
+	method := s.precompile.Methods[registry.GetPrecompilesMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expDisabled []string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{s.precompile.Address()}
+			},
+			false,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"pass - all precompiles enabled",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			"",
+			nil,
+		},
+		{
+			"pass - disabled precompile",
+			func() []interface{} {
+				s.disableGovPrecompile()
+				return []interface{}{}
+			},
+			true,
+			"",
+			[]string{evmtypes.GovPrecompileAddress},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.GetPrecompiles(s.network.GetContext(), &method, tc.malleate())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var out struct{ Precompiles []registry.PrecompileInfo }
+				err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().Len(out.Precompiles, len(evmtypes.AvailableStaticPrecompiles))
+
+				for i, info := range out.Precompiles {
+					hexAddr := evmtypes.AvailableStaticPrecompiles[i]
+					s.Require().Equal(common.HexToAddress(hexAddr), info.PrecompileAddress)
+					s.Require().Equal(evmtypes.StaticPrecompilesMetadata[hexAddr].Name, info.Name)
+					s.Require().Equal(!slices.Contains(tc.expDisabled, hexAddr), info.Enabled, hexAddr)
+				}
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			}
+		})
+	}
+}
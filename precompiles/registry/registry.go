@@ -0,0 +1,141 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+//
+// The registry package contains the implementation of the precompile that
+// reports which static precompiles are enabled at the current height, so that
+// contracts can detect the available features.
+
+package registry
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// GasIsEnabled defines the gas cost for the isEnabled query
+	GasIsEnabled = 2_500
+
+	// GasGetPrecompile defines the gas cost for the getPrecompile query
+	GasGetPrecompile = 3_000
+
+	// GasGetPrecompiles defines the gas cost for the getPrecompiles query
+	GasGetPrecompiles = 10_000
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the registry precompile
+type Precompile struct {
+	cmn.Precompile
+	evmKeeper EVMKeeper
+}
+
+// NewPrecompile creates a new registry Precompile instance implementing the
+// PrecompiledContract interface.
+func NewPrecompile(evmKeeper EVMKeeper) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: we set an empty gas configuration to avoid extra gas costs
+	// during the run execution
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newABI,
+			KvGasConfig:          storetypes.GasConfig{},
+			TransientKVGasConfig: storetypes.GasConfig{},
+		},
+		evmKeeper: evmKeeper,
+	}
+
+	// SetAddress defines the address of the registry compile contract.
+	p.SetAddress(common.HexToAddress(evmtypes.RegistryPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	switch method.Name {
+	case IsEnabledMethod:
+		return GasIsEnabled
+	case GetPrecompileMethod:
+		return GasGetPrecompile
+	case GetPrecompilesMethod:
+		return GasGetPrecompiles
+	}
+
+	return 0
+}
+
+// Run executes the precompiled contract registry query methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// Registry queries
+	case IsEnabledMethod:
+		bz, err = p.IsEnabled(ctx, method, args)
+	case GetPrecompileMethod:
+		bz, err = p.GetPrecompile(ctx, method, args)
+	case GetPrecompilesMethod:
+		bz, err = p.GetPrecompiles(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// It returns false since all registry methods are queries.
+func (Precompile) IsTransaction(_ string) bool {
+	return false
+}
@@ -0,0 +1,30 @@
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v20/precompiles/registry"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/suite"
+)
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the registry
+// precompile unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network    *network.UnitTestNetwork
+	precompile *registry.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	s.network = network.NewUnitTestNetwork()
+
+	precompile, err := registry.NewPrecompile(s.network.App.EvmKeeper)
+	s.Require().NoError(err)
+	s.precompile = precompile
+}
@@ -0,0 +1,54 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package registry
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// EVMKeeper defines the expected EVM keeper used to retrieve the information
+// of the static precompiles.
+type EVMKeeper interface {
+	GetStaticPrecompilesInfo(ctx sdk.Context) []evmtypes.PrecompileInfo
+}
+
+// PrecompileInfo contains the information of a static precompile.
+type PrecompileInfo struct {
+	PrecompileAddress common.Address
+	Name              string
+	Version           uint64
+	AbiHash           [32]byte
+	Enabled           bool
+}
+
+// NewPrecompileInfo creates the ABI representation of the given precompile information.
+func NewPrecompileInfo(info evmtypes.PrecompileInfo) PrecompileInfo {
+	return PrecompileInfo{
+		PrecompileAddress: info.Address,
+		Name:              info.Name,
+		Version:           info.Version,
+		AbiHash:           info.ABIHash,
+		Enabled:           info.Enabled,
+	}
+}
+
+// ParsePrecompileArgs parses the call arguments for the registry queries that
+// take a precompile address.
+func ParsePrecompileArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	precompile, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "precompile", common.Address{}, args[0])
+	}
+
+	return precompile, nil
+}
@@ -13,6 +13,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v20/rpc/backend/mocks"
 	rpc "github.com/evmos/evmos/v20/rpc/types"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
@@ -51,6 +52,24 @@ func RegisterTraceTransactionError(queryClient *mocks.EVMQueryClient, msgEthTx *
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// RegisterTraceTxAccessList registers the access list trace of an unsigned
+// call from the given sender, for the access list provided in the tx.
+func RegisterTraceTxAccessList(queryClient *mocks.EVMQueryClient, from common.Address, txAccessList ethtypes.AccessList, result rpc.AccessListResult) {
+	data, _ := json.Marshal(result)
+	queryClient.On("TraceTx", rpc.ContextWithHeight(1), mock.MatchedBy(func(req *evmtypes.QueryTraceTxRequest) bool {
+		return req.UnsignedCall &&
+			req.TraceConfig.Tracer == accessListTracer &&
+			req.Msg.From == from.Hex() &&
+			AccessListsEqual(txAccessList, req.Msg.AsTransaction().AccessList())
+	})).
+		Return(&evmtypes.QueryTraceTxResponse{Data: data}, nil)
+}
+
+func RegisterTraceTxAccessListError(queryClient *mocks.EVMQueryClient) {
+	queryClient.On("TraceTx", rpc.ContextWithHeight(1), mock.AnythingOfType("*types.QueryTraceTxRequest")).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // TraceBlock
 func RegisterTraceBlock(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx) {
 	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
@@ -380,6 +380,43 @@ func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsReque
 	return r0, r1
 }
 
+// StaticPrecompiles provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) StaticPrecompiles(ctx context.Context, in *types.QueryStaticPrecompilesRequest, opts ...grpc.CallOption) (*types.QueryStaticPrecompilesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StaticPrecompiles")
+	}
+
+	var r0 *types.QueryStaticPrecompilesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStaticPrecompilesRequest, ...grpc.CallOption) (*types.QueryStaticPrecompilesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStaticPrecompilesRequest, ...grpc.CallOption) *types.QueryStaticPrecompilesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryStaticPrecompilesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStaticPrecompilesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Storage provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageRequest, opts ...grpc.CallOption) (*types.QueryStorageResponse, error) {
 	_va := make([]interface{}, len(opts))
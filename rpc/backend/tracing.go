@@ -11,11 +11,20 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	rpctypes "github.com/evmos/evmos/v20/rpc/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 	"github.com/pkg/errors"
 )
 
+const (
+	// accessListTracer is the native tracer used to generate access lists
+	accessListTracer = "accessListTracer"
+	// maxAccessListIterations bounds the amount of traces run to generate an
+	// access list, as each run may touch a different set of accounts and slots
+	maxAccessListIterations = 10
+)
+
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
@@ -209,3 +218,86 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// CreateAccessList returns the list of addresses and storage keys used by the
+// transaction (except for the sender, the recipient and the precompiles), plus
+// the gas consumed when the access list is added. The transaction is traced
+// repeatedly with the access list from the previous run until the list
+// doesn't change anymore.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) (*rpctypes.AccessListResult, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	// the sender is required to simulate the transaction as a call
+	if args.From == nil {
+		args.From = &common.Address{}
+	}
+
+	args, err = b.SetTxDefaults(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	prevAccessList := ethtypes.AccessList{}
+	if args.AccessList != nil {
+		prevAccessList = *args.AccessList
+	}
+
+	var result rpctypes.AccessListResult
+	for i := 0; i < maxAccessListIterations; i++ {
+		accessList := prevAccessList
+		args.AccessList = &accessList
+
+		traceTxRequest := evmtypes.QueryTraceTxRequest{
+			Msg:             args.ToTransaction(),
+			BlockNumber:     blk.Block.Height,
+			BlockTime:       blk.Block.Time,
+			BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+			ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+			ChainId:         b.chainID.Int64(),
+			BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+			TraceConfig:     &evmtypes.TraceConfig{Tracer: accessListTracer},
+			UnsignedCall:    true,
+		}
+
+		traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(blk.Block.Height), &traceTxRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		result = rpctypes.AccessListResult{}
+		if err := json.Unmarshal(traceResult.Data, &result); err != nil {
+			return nil, err
+		}
+
+		if result.Accesslist == nil || AccessListsEqual(prevAccessList, *result.Accesslist) {
+			break
+		}
+		prevAccessList = *result.Accesslist
+	}
+
+	if result.Accesslist == nil {
+		result.Accesslist = &prevAccessList
+	}
+	return &result, nil
+}
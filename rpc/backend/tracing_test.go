@@ -2,19 +2,25 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v20/indexer"
 	"github.com/evmos/evmos/v20/rpc/backend/mocks"
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	"google.golang.org/grpc/metadata"
 )
 
 func (suite *BackendTestSuite) TestTraceTransaction() {
@@ -260,3 +266,118 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	var header metadata.MD
+	from := utiltx.GenerateAddress()
+	toAddr := utiltx.GenerateAddress()
+	gasPrice := (*hexutil.Big)(big.NewInt(1))
+	gas := hexutil.Uint64(100_000)
+	nonce := hexutil.Uint64(1)
+	blockNum := rpctypes.BlockNumber(1)
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}
+
+	accessList := ethtypes.AccessList{
+		{Address: toAddr, StorageKeys: []common.Hash{{0x1}}},
+	}
+	fullAccessList := ethtypes.AccessList{
+		{Address: toAddr, StorageKeys: []common.Hash{{0x1}, {0x2}}},
+		{Address: utiltx.GenerateAddress(), StorageKeys: []common.Hash{}},
+	}
+
+	registerDefaults := func() *mocks.EVMQueryClient {
+		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		RegisterParams(queryClient, &header, 1)
+		_, err := RegisterBlock(client, 1, nil)
+		suite.Require().NoError(err)
+		_, err = RegisterBlockResults(client, 1)
+		suite.Require().NoError(err)
+		RegisterBaseFee(queryClient, math.NewInt(1))
+		RegisterConsensusParams(client, 1)
+		return queryClient
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		from         *common.Address
+		expResult    *rpctypes.AccessListResult
+		expCalls     int
+		expPass      bool
+	}{
+		{
+			"fail - trace error",
+			func() {
+				queryClient := registerDefaults()
+				RegisterTraceTxAccessListError(queryClient)
+			},
+			&from,
+			nil,
+			1,
+			false,
+		},
+		{
+			"pass - access list stable after the first trace",
+			func() {
+				queryClient := registerDefaults()
+				RegisterTraceTxAccessList(queryClient, from, nil, rpctypes.AccessListResult{Accesslist: &ethtypes.AccessList{}, GasUsed: 21000})
+			},
+			&from,
+			&rpctypes.AccessListResult{Accesslist: &ethtypes.AccessList{}, GasUsed: 21000},
+			1,
+			true,
+		},
+		{
+			"pass - access list is traced until it doesn't change",
+			func() {
+				queryClient := registerDefaults()
+				RegisterTraceTxAccessList(queryClient, from, nil, rpctypes.AccessListResult{Accesslist: &accessList, GasUsed: 30000})
+				RegisterTraceTxAccessList(queryClient, from, accessList, rpctypes.AccessListResult{Accesslist: &fullAccessList, GasUsed: 35000})
+				RegisterTraceTxAccessList(queryClient, from, fullAccessList, rpctypes.AccessListResult{Accesslist: &fullAccessList, GasUsed: 34000})
+			},
+			&from,
+			&rpctypes.AccessListResult{Accesslist: &fullAccessList, GasUsed: 34000},
+			3,
+			true,
+		},
+		{
+			"pass - sender defaults to the zero address",
+			func() {
+				queryClient := registerDefaults()
+				RegisterTraceTxAccessList(queryClient, common.Address{}, nil, rpctypes.AccessListResult{Accesslist: &accessList, GasUsed: 30000})
+				RegisterTraceTxAccessList(queryClient, common.Address{}, accessList, rpctypes.AccessListResult{Accesslist: &accessList, GasUsed: 28000})
+			},
+			nil,
+			&rpctypes.AccessListResult{Accesslist: &accessList, GasUsed: 28000},
+			2,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			args := evmtypes.TransactionArgs{
+				From:     tc.from,
+				To:       &toAddr,
+				GasPrice: gasPrice,
+				Gas:      &gas,
+				Nonce:    &nonce,
+			}
+			result, err := suite.backend.CreateAccessList(args, blockNrOrHash)
+
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			queryClient.AssertNumberOfCalls(suite.T(), "TraceTx", tc.expCalls)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult.GasUsed, result.GasUsed)
+				suite.Require().True(AccessListsEqual(*tc.expResult.Accesslist, *result.Accesslist))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
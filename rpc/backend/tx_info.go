@@ -255,6 +255,16 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
+	// accessListUsed and accessStats extend the receipt when the node records
+	// the accounts and storage slots touched by each transaction
+	accessList, err := TxAccessListFromEvents(blockRes.TxsResults[res.TxIndex].Events, hash)
+	if err != nil {
+		b.logger.Debug("failed to parse access list", "hash", hexTx, "error", err.Error())
+	} else if accessList != nil {
+		receipt["accessListUsed"] = accessList.AccessList
+		receipt["accessStats"] = accessList.Stats
+	}
+
 	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
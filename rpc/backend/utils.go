@@ -264,6 +264,74 @@ func ParseTxLogsFromEvent(event abci.Event) ([]*ethtypes.Log, error) {
 	return evmtypes.LogsToEthereum(logs), nil
 }
 
+// TxAccessListFromEvents parses the access list recorded for the given ethereum
+// transaction hash. It returns nil if the node didn't record it, which is the
+// case unless it runs with the access_list EVM tracer.
+func TxAccessListFromEvents(events []abci.Event, txHash common.Hash) (*evmtypes.TxAccessList, error) {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxAccessList {
+			continue
+		}
+
+		var (
+			hash  common.Hash
+			value string
+		)
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case evmtypes.AttributeKeyEthereumTxHash:
+				hash = common.HexToHash(attr.Value)
+			case evmtypes.AttributeKeyTxAccessList:
+				value = attr.Value
+			}
+		}
+		if hash != txHash {
+			continue
+		}
+
+		var accessList evmtypes.TxAccessList
+		if err := json.Unmarshal([]byte(value), &accessList); err != nil {
+			return nil, err
+		}
+		return &accessList, nil
+	}
+	return nil, nil
+}
+
+// AccessListsEqual checks if both access lists contain the same accounts and
+// storage slots, regardless of their order.
+func AccessListsEqual(a, b ethtypes.AccessList) bool {
+	toSet := func(al ethtypes.AccessList) map[common.Address]map[common.Hash]struct{} {
+		set := make(map[common.Address]map[common.Hash]struct{}, len(al))
+		for _, tuple := range al {
+			if _, ok := set[tuple.Address]; !ok {
+				set[tuple.Address] = make(map[common.Hash]struct{})
+			}
+			for _, key := range tuple.StorageKeys {
+				set[tuple.Address][key] = struct{}{}
+			}
+		}
+		return set
+	}
+
+	setA, setB := toSet(a), toSet(b)
+	if len(setA) != len(setB) {
+		return false
+	}
+	for addr, slotsA := range setA {
+		slotsB, ok := setB[addr]
+		if !ok || len(slotsA) != len(slotsB) {
+			return false
+		}
+		for key := range slotsA {
+			if _, ok := slotsB[key]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // ShouldIgnoreGasUsed returns true if the gasUsed in result should be ignored
 // workaround for issue: https://github.com/cosmos/cosmos-sdk/issues/10832
 func ShouldIgnoreGasUsed(res *abci.ExecTxResult) bool {
@@ -3,7 +3,12 @@ package backend
 import (
 	"fmt"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v20/x/evm/core/logger"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 func mookProofs(num int, withData bool) *crypto.ProofOps {
@@ -50,3 +55,122 @@ func (suite *BackendTestSuite) TestGetHexProofs() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestAccessListsEqual() {
+	addr1 := common.BytesToAddress([]byte("addr1"))
+	addr2 := common.BytesToAddress([]byte("addr2"))
+	slot1 := common.BytesToHash([]byte("slot1"))
+	slot2 := common.BytesToHash([]byte("slot2"))
+
+	testCases := []struct {
+		name string
+		a    ethtypes.AccessList
+		b    ethtypes.AccessList
+		exp  bool
+	}{
+		{
+			"empty access lists",
+			ethtypes.AccessList{},
+			nil,
+			true,
+		},
+		{
+			"same accounts and slots in different order",
+			ethtypes.AccessList{
+				{Address: addr1, StorageKeys: []common.Hash{slot1, slot2}},
+				{Address: addr2, StorageKeys: []common.Hash{}},
+			},
+			ethtypes.AccessList{
+				{Address: addr2, StorageKeys: []common.Hash{}},
+				{Address: addr1, StorageKeys: []common.Hash{slot2, slot1}},
+			},
+			true,
+		},
+		{
+			"different accounts",
+			ethtypes.AccessList{{Address: addr1, StorageKeys: []common.Hash{}}},
+			ethtypes.AccessList{{Address: addr2, StorageKeys: []common.Hash{}}},
+			false,
+		},
+		{
+			"different slots",
+			ethtypes.AccessList{{Address: addr1, StorageKeys: []common.Hash{slot1}}},
+			ethtypes.AccessList{{Address: addr1, StorageKeys: []common.Hash{slot2}}},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.Require().Equal(tc.exp, AccessListsEqual(tc.a, tc.b))
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTxAccessListFromEvents() {
+	txHash := common.BytesToHash([]byte("tx"))
+	addr := common.BytesToAddress([]byte("addr"))
+
+	testCases := []struct {
+		name    string
+		events  []abci.Event
+		exp     *evmtypes.TxAccessList
+		expPass bool
+	}{
+		{
+			"access list not recorded",
+			[]abci.Event{{Type: evmtypes.EventTypeEthereumTx}},
+			nil,
+			true,
+		},
+		{
+			"access list of another tx",
+			[]abci.Event{{
+				Type: evmtypes.EventTypeTxAccessList,
+				Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyEthereumTxHash, Value: common.BytesToHash([]byte("other")).Hex()},
+					{Key: evmtypes.AttributeKeyTxAccessList, Value: `{}`},
+				},
+			}},
+			nil,
+			true,
+		},
+		{
+			"invalid access list",
+			[]abci.Event{{
+				Type: evmtypes.EventTypeTxAccessList,
+				Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyEthereumTxHash, Value: txHash.Hex()},
+					{Key: evmtypes.AttributeKeyTxAccessList, Value: `invalid`},
+				},
+			}},
+			nil,
+			false,
+		},
+		{
+			"valid access list",
+			[]abci.Event{{
+				Type: evmtypes.EventTypeTxAccessList,
+				Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyEthereumTxHash, Value: txHash.Hex()},
+					{Key: evmtypes.AttributeKeyTxAccessList, Value: `{"accessList":[{"address":"` + addr.Hex() + `","storageKeys":[]}],"stats":{"coldAccounts":1,"warmAccounts":2,"coldSlots":0,"warmSlots":0}}`},
+				},
+			}},
+			&evmtypes.TxAccessList{
+				AccessList: ethtypes.AccessList{{Address: addr, StorageKeys: []common.Hash{}}},
+				Stats:      logger.AccessStats{ColdAccounts: 1, WarmAccounts: 2},
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			accessList, err := TxAccessListFromEvents(tc.events, txHash)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.exp, accessList)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
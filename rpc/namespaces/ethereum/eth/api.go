@@ -72,6 +72,7 @@ type EthereumAPI interface {
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash) (*rpctypes.AccessListResult, error)
 
 	// Chain Information
 	//
@@ -283,6 +284,21 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// CreateAccessList creates an EIP-2930 type AccessList for the given transaction.
+// BlockNrOrHash can be specified to create the accessList on top of a certain state.
+func (e *PublicAPI) CreateAccessList(args evmtypes.TransactionArgs,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	pending := rpctypes.EthPendingBlockNumber
+	bNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &pending}
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	return e.backend.CreateAccessList(args, bNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -84,6 +84,15 @@ type SignTransactionResult struct {
 	Tx  *ethtypes.Transaction `json:"tx"`
 }
 
+// AccessListResult returns an optional accesslist.
+// It's the result of the `eth_createAccessList` RPC call.
+// It contains an error if the transaction itself failed.
+type AccessListResult struct {
+	Accesslist *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
 type OneFeeHistory struct {
 	BaseFee, NextBaseFee *big.Int   // base fee for each block
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
@@ -0,0 +1,111 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+// Package dust defines the rounding policy shared by the modules that split or
+// rescale coin amounts. Amounts are always truncated toward zero and the resulting
+// remainder (dust) is sent to a single destination and tracked through events,
+// instead of being dropped differently by each module.
+package dust
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+)
+
+const (
+	// EventTypeDust is emitted every time a module produces a remainder.
+	EventTypeDust = "dust"
+
+	// AttributeKeySource is the name of the module that produced the dust.
+	AttributeKeySource = "source"
+	// AttributeKeyDestination is the destination the dust was sent to.
+	AttributeKeyDestination = "destination"
+)
+
+const (
+	// DestinationCommunityPool is used when the dust is sent to the community pool.
+	DestinationCommunityPool = "community_pool"
+	// DestinationStakingRewards is used when the dust is added to the staking rewards.
+	DestinationStakingRewards = "staking_rewards"
+	// DestinationValidator is used when the dust is left in the validator tokens,
+	// and therefore shared by its remaining delegators.
+	DestinationValidator = "validator"
+	// DestinationTruncated is used when the sub-unit remainder of a rescaled
+	// amount cannot be represented in the bank denomination, and is therefore
+	// neither minted, burned nor transferred.
+	DestinationTruncated = "truncated"
+)
+
+// MulDec multiplies the amount by the given ratio and truncates the result
+// toward zero. It returns the truncated result and the sub-unit remainder.
+func MulDec(amt math.Int, ratio math.LegacyDec) (math.Int, math.LegacyDec) {
+	product := math.LegacyNewDecFromInt(amt).Mul(ratio)
+	result := product.TruncateInt()
+	return result, product.Sub(math.LegacyNewDecFromInt(result))
+}
+
+// Quo divides the amount by the given divisor and truncates the result toward
+// zero. It returns the truncated quotient and the remainder.
+func Quo(amt, divisor math.Int) (math.Int, math.Int) {
+	quotient := amt.Quo(divisor)
+	return quotient, amt.Sub(quotient.Mul(divisor))
+}
+
+// Split allocates the amount according to the given ratios, truncating each
+// share toward zero. The returned dust is the difference between the amount
+// allocated by the sum of the ratios and the sum of the truncated shares. When
+// the ratios add up to one, the shares and the dust add up to the amount.
+func Split(amt math.Int, ratios ...math.LegacyDec) (shares []math.Int, dust math.Int) {
+	shares = make([]math.Int, len(ratios))
+	total := math.LegacyZeroDec()
+	allocated := math.ZeroInt()
+	for i, ratio := range ratios {
+		shares[i], _ = MulDec(amt, ratio)
+		allocated = allocated.Add(shares[i])
+		total = total.Add(ratio)
+	}
+
+	expected, _ := MulDec(amt, total)
+	return shares, expected.Sub(allocated)
+}
+
+// Track emits the event and telemetry that keep record of the dust produced by
+// the given source and sent to the given destination.
+func Track(ctx sdk.Context, source, destination string, dust sdk.Coins) {
+	if dust.IsZero() {
+		return
+	}
+
+	TrackDec(ctx, source, destination, sdk.NewDecCoinsFromCoins(dust...))
+}
+
+// TrackDec is the same as Track for sub-unit remainders, which cannot be
+// transferred and are therefore left where they were produced.
+func TrackDec(ctx sdk.Context, source, destination string, dust sdk.DecCoins) {
+	if dust.IsZero() {
+		return
+	}
+
+	for _, coin := range dust {
+		telemetry.IncrCounterWithLabels(
+			[]string{"dust", "total"},
+			float32(coin.Amount.MustFloat64()),
+			[]metrics.Label{
+				telemetry.NewLabel("source", source),
+				telemetry.NewLabel("destination", destination),
+				telemetry.NewLabel("denom", coin.Denom),
+			},
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeDust,
+			sdk.NewAttribute(AttributeKeySource, source),
+			sdk.NewAttribute(AttributeKeyDestination, destination),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, dust.String()),
+		),
+	)
+}
@@ -0,0 +1,180 @@
+package dust
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMulDec(t *testing.T) {
+	testCases := []struct {
+		name      string
+		amt       math.Int
+		ratio     math.LegacyDec
+		expResult math.Int
+		expDust   math.LegacyDec
+	}{
+		{
+			"no remainder",
+			math.NewInt(100),
+			math.LegacyNewDecWithPrec(5, 1),
+			math.NewInt(50),
+			math.LegacyZeroDec(),
+		},
+		{
+			"remainder is truncated",
+			math.NewInt(101),
+			math.LegacyNewDecWithPrec(5, 1),
+			math.NewInt(50),
+			math.LegacyNewDecWithPrec(5, 1),
+		},
+		{
+			"zero amount",
+			math.ZeroInt(),
+			math.LegacyNewDecWithPrec(5, 1),
+			math.ZeroInt(),
+			math.LegacyZeroDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, dust := MulDec(tc.amt, tc.ratio)
+			require.Equal(t, tc.expResult.String(), result.String())
+			require.Equal(t, tc.expDust.String(), dust.String())
+		})
+	}
+}
+
+func TestQuo(t *testing.T) {
+	testCases := []struct {
+		name      string
+		amt       math.Int
+		divisor   math.Int
+		expResult math.Int
+		expDust   math.Int
+	}{
+		{
+			"no remainder",
+			math.NewInt(2e12),
+			math.NewInt(1e12),
+			math.NewInt(2),
+			math.ZeroInt(),
+		},
+		{
+			"remainder is truncated",
+			math.NewInt(2e12 + 1),
+			math.NewInt(1e12),
+			math.NewInt(2),
+			math.OneInt(),
+		},
+		{
+			"amount lower than the divisor",
+			math.NewInt(999),
+			math.NewInt(1e12),
+			math.ZeroInt(),
+			math.NewInt(999),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, dust := Quo(tc.amt, tc.divisor)
+			require.Equal(t, tc.expResult.String(), result.String())
+			require.Equal(t, tc.expDust.String(), dust.String())
+			require.Equal(t, tc.amt.String(), result.Mul(tc.divisor).Add(dust).String())
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	testCases := []struct {
+		name      string
+		amt       math.Int
+		ratios    []math.LegacyDec
+		expShares []math.Int
+		expDust   math.Int
+	}{
+		{
+			"no ratios",
+			math.NewInt(100),
+			nil,
+			[]math.Int{},
+			math.ZeroInt(),
+		},
+		{
+			"exact split",
+			math.NewInt(100),
+			[]math.LegacyDec{math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1)},
+			[]math.Int{math.NewInt(50), math.NewInt(50)},
+			math.ZeroInt(),
+		},
+		{
+			"split with dust",
+			math.NewInt(101),
+			[]math.LegacyDec{math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1)},
+			[]math.Int{math.NewInt(50), math.NewInt(50)},
+			math.OneInt(),
+		},
+		{
+			"partial split with dust",
+			math.NewInt(10),
+			[]math.LegacyDec{math.LegacyNewDecWithPrec(25, 2), math.LegacyNewDecWithPrec(25, 2)},
+			[]math.Int{math.NewInt(2), math.NewInt(2)},
+			math.OneInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			shares, dust := Split(tc.amt, tc.ratios...)
+			require.Equal(t, tc.expShares, shares)
+			require.Equal(t, tc.expDust.String(), dust.String())
+		})
+	}
+}
+
+func TestTrack(t *testing.T) {
+	testCases := []struct {
+		name      string
+		dust      sdk.DecCoins
+		expEvents int
+	}{
+		{
+			"no dust",
+			sdk.DecCoins{},
+			0,
+		},
+		{
+			"sub-unit dust",
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("aevmos", math.LegacyNewDecWithPrec(5, 1))),
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+			TrackDec(ctx, "source", DestinationValidator, tc.dust)
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, tc.expEvents)
+			if tc.expEvents == 0 {
+				return
+			}
+
+			require.Equal(t, EventTypeDust, events[0].Type)
+			source, ok := events[0].GetAttribute(AttributeKeySource)
+			require.True(t, ok)
+			require.Equal(t, "source", source.Value)
+			destination, ok := events[0].GetAttribute(AttributeKeyDestination)
+			require.True(t, ok)
+			require.Equal(t, DestinationValidator, destination.Value)
+			amount, ok := events[0].GetAttribute(sdk.AttributeKeyAmount)
+			require.True(t, ok)
+			require.Equal(t, tc.dust.String(), amount.Value)
+		})
+	}
+}
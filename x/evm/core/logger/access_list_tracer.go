@@ -85,11 +85,22 @@ func (al accessList) accessList() types.AccessList {
 	return acl
 }
 
+// AccessStats counts the cold and warm (EIP-2929) account and storage slot
+// accesses observed during an EVM contract execution.
+type AccessStats struct {
+	ColdAccounts uint64 `json:"coldAccounts"`
+	WarmAccounts uint64 `json:"warmAccounts"`
+	ColdSlots    uint64 `json:"coldSlots"`
+	WarmSlots    uint64 `json:"warmSlots"`
+}
+
 // AccessListTracer is a tracer that accumulates touched accounts and storage
 // slots into an internal set.
 type AccessListTracer struct {
-	excl map[common.Address]struct{} // Set of account to exclude from the list
-	list accessList                  // Set of accounts and storage slots touched
+	excl  map[common.Address]struct{} // Set of account to exclude from the list
+	list  accessList                  // Set of accounts and storage slots touched
+	warm  accessList                  // Set of accounts and storage slots already warm (EIP-2929)
+	stats AccessStats                 // Cold and warm access counters
 }
 
 // NewAccessListTracer creates a new tracer that can generate AccessLists.
@@ -103,21 +114,30 @@ func NewAccessListTracer(acl types.AccessList, from, to common.Address, precompi
 		excl[addr] = struct{}{}
 	}
 	list := newAccessList()
+	// the warm set mirrors the EIP-2929 access list prepared by the EVM before
+	// execution: sender, recipient, precompiles and the tx access list.
+	warm := newAccessList()
+	for addr := range excl {
+		warm.addAddress(addr)
+	}
 	for _, al := range acl {
 		if _, ok := excl[al.Address]; !ok {
 			list.addAddress(al.Address)
 		}
+		warm.addAddress(al.Address)
 		for _, slot := range al.StorageKeys {
 			list.addSlot(al.Address, slot)
+			warm.addSlot(al.Address, slot)
 		}
 	}
 	return &AccessListTracer{
 		excl: excl,
 		list: list,
+		warm: warm,
 	}
 }
 
-func (a *AccessListTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+func (*AccessListTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
 }
 
 // CaptureState captures all opcodes that touch storage or addresses and adds them to the accesslist.
@@ -127,16 +147,19 @@ func (a *AccessListTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint6
 	stackLen := len(stackData)
 	if (op == vm.SLOAD || op == vm.SSTORE) && stackLen >= 1 {
 		slot := common.Hash(stackData[stackLen-1].Bytes32())
+		a.countSlot(scope.Contract.Address(), slot)
 		a.list.addSlot(scope.Contract.Address(), slot)
 	}
 	if (op == vm.EXTCODECOPY || op == vm.EXTCODEHASH || op == vm.EXTCODESIZE || op == vm.BALANCE || op == vm.SELFDESTRUCT) && stackLen >= 1 {
 		addr := common.Address(stackData[stackLen-1].Bytes20())
+		a.countAddress(addr)
 		if _, ok := a.excl[addr]; !ok {
 			a.list.addAddress(addr)
 		}
 	}
 	if (op == vm.DELEGATECALL || op == vm.CALL || op == vm.STATICCALL || op == vm.CALLCODE) && stackLen >= 5 {
 		addr := common.Address(stackData[stackLen-2].Bytes20())
+		a.countAddress(addr)
 		if _, ok := a.excl[addr]; !ok {
 			a.list.addAddress(addr)
 		}
@@ -148,7 +171,12 @@ func (*AccessListTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64,
 
 func (*AccessListTracer) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) {}
 
-func (*AccessListTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+// CaptureEnter marks the address of a contract being created as warm, as the
+// EVM adds it to the access list before running the init code.
+func (a *AccessListTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if typ == vm.CREATE || typ == vm.CREATE2 {
+		a.warm.addAddress(to)
+	}
 }
 
 func (*AccessListTracer) CaptureExit(output []byte, gasUsed uint64, err error) {}
@@ -157,6 +185,34 @@ func (*AccessListTracer) CaptureTxStart(gasLimit uint64) {}
 
 func (*AccessListTracer) CaptureTxEnd(restGas uint64) {}
 
+// countAddress records a cold or warm account access. The access is classified
+// against the tracer's own warm set, since the EVM adds the account to its
+// access list while charging the dynamic gas, before CaptureState is called.
+// The first access to an account is cold and the following ones are warm.
+func (a *AccessListTracer) countAddress(addr common.Address) {
+	if _, ok := a.warm[addr]; ok {
+		a.stats.WarmAccounts++
+		return
+	}
+	a.stats.ColdAccounts++
+	a.warm.addAddress(addr)
+}
+
+// countSlot records a cold or warm storage slot access.
+func (a *AccessListTracer) countSlot(addr common.Address, slot common.Hash) {
+	if _, ok := a.warm[addr][slot]; ok {
+		a.stats.WarmSlots++
+		return
+	}
+	a.stats.ColdSlots++
+	a.warm.addSlot(addr, slot)
+}
+
+// Stats returns the cold and warm access counters collected by the tracer.
+func (a *AccessListTracer) Stats() AccessStats {
+	return a.stats
+}
+
 // AccessList returns the current accesslist maintained by the tracer.
 func (a *AccessListTracer) AccessList() types.AccessList {
 	return a.list.accessList()
@@ -0,0 +1,88 @@
+package logger_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v20/x/evm/core/logger"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessListTracer(t *testing.T) {
+	var (
+		from     = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		other    = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	)
+
+	// SLOAD(1), SLOAD(1), SLOAD(2), BALANCE(other), BALANCE(other), STOP
+	code := common.Hex2Bytes("600154506001545060025450")
+	code = append(code, append(append([]byte{byte(vm.PUSH20)}, other.Bytes()...), byte(vm.BALANCE), byte(vm.POP))...)
+	code = append(code, append(append([]byte{byte(vm.PUSH20)}, other.Bytes()...), byte(vm.BALANCE), byte(vm.POP))...)
+	code = append(code, byte(vm.STOP))
+
+	testCases := []struct {
+		name       string
+		acl        types.AccessList
+		expStats   logger.AccessStats
+		expAccList types.AccessList
+	}{
+		{
+			"cold access followed by warm access",
+			nil,
+			logger.AccessStats{ColdAccounts: 1, WarmAccounts: 1, ColdSlots: 2, WarmSlots: 1},
+			types.AccessList{
+				{Address: contract, StorageKeys: []common.Hash{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))}},
+				{Address: other, StorageKeys: []common.Hash{}},
+			},
+		},
+		{
+			"accesses warmed by the tx access list",
+			types.AccessList{
+				{Address: contract, StorageKeys: []common.Hash{common.BigToHash(big.NewInt(2))}},
+				{Address: other, StorageKeys: []common.Hash{}},
+			},
+			logger.AccessStats{ColdAccounts: 0, WarmAccounts: 2, ColdSlots: 1, WarmSlots: 2},
+			types.AccessList{
+				{Address: contract, StorageKeys: []common.Hash{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))}},
+				{Address: other, StorageKeys: []common.Hash{}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+			require.NoError(t, err)
+			statedb.CreateAccount(contract)
+			statedb.SetCode(contract, code)
+			statedb.Finalise(true)
+
+			rules := params.AllEthashProtocolChanges.Rules(big.NewInt(0), false)
+			precompiles := vm.DefaultActivePrecompiles(rules)
+			statedb.PrepareAccessList(from, &contract, precompiles, tc.acl)
+
+			tracer := logger.NewAccessListTracer(tc.acl, from, contract, precompiles)
+			vmctx := vm.BlockContext{
+				CanTransfer: func(vm.StateDB, common.Address, *big.Int) bool { return true },
+				Transfer:    func(vm.StateDB, common.Address, common.Address, *big.Int) {},
+				BlockNumber: big.NewInt(0),
+			}
+			evm := vm.NewEVM(vmctx, vm.TxContext{}, statedb, params.AllEthashProtocolChanges, vm.Config{Debug: true, Tracer: tracer})
+
+			_, _, err = evm.Call(vm.AccountRef(from), contract, nil, 100_000, new(big.Int))
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expStats, tracer.Stats())
+
+			expTracer := logger.NewAccessListTracer(tc.expAccList, from, common.Address{}, nil)
+			require.True(t, tracer.Equal(expTracer), "access list %v", tracer.AccessList())
+		})
+	}
+}
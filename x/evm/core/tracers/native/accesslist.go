@@ -0,0 +1,135 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v20/x/evm/core/logger"
+	"github.com/evmos/evmos/v20/x/evm/core/tracers"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func init() {
+	register("accessListTracer", newAccessListTracer)
+}
+
+// accessListResult is the result returned by the accessListTracer.
+type accessListResult struct {
+	AccessList types.AccessList   `json:"accessList"`
+	GasUsed    hexutil.Uint64     `json:"gasUsed"`
+	Error      string             `json:"error,omitempty"`
+	Stats      logger.AccessStats `json:"stats"`
+}
+
+// accessListTracer collects the accounts and storage slots touched by a
+// transaction, together with the amount of cold and warm accesses. The
+// resulting access list excludes the sender, the recipient and the active
+// precompiles, as those are always warm.
+//
+// Example:
+//
+//	> debug.traceTransaction( "0x214e597e35da083692f5386141e69f47e973b2c56e7a8073b1ea08fd7571e9de", {tracer: "accessListTracer"})
+//	{
+//	  accessList: [{address: "0x...", storageKeys: ["0x..."]}],
+//	  gasUsed: "0x5208",
+//	  stats: {coldAccounts: 1, warmAccounts: 0, coldSlots: 1, warmSlots: 2}
+//	}
+type accessListTracer struct {
+	inner     *logger.AccessListTracer
+	acl       types.AccessList // Access list of the traced tx, warm from the start
+	gasLimit  uint64
+	gasUsed   uint64
+	err       error  // Error of the top-level call, if any
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// newAccessListTracer returns a native go tracer which collects the access
+// list of a tx, and implements vm.EVMLogger.
+func newAccessListTracer(ctx *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+	t := &accessListTracer{}
+	if ctx != nil {
+		t.acl = ctx.AccessList
+	}
+	return t, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *accessListTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	rules := env.ChainConfig().Rules(env.Context.BlockNumber, env.Context.Random != nil)
+	t.inner = logger.NewAccessListTracer(t.acl, from, to, env.ActivePrecompiles(rules))
+	t.inner.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *accessListTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 || t.inner == nil {
+		return
+	}
+	t.inner.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *accessListTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 || t.inner == nil {
+		return
+	}
+	t.inner.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *accessListTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *accessListTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, _ *vm.ScopeContext, depth int, err error) {
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *accessListTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	t.err = err
+}
+
+func (t *accessListTracer) CaptureTxStart(gasLimit uint64) {
+	t.gasLimit = gasLimit
+}
+
+func (t *accessListTracer) CaptureTxEnd(restGas uint64) {
+	t.gasUsed = t.gasLimit - restGas
+}
+
+// GetResult returns the json-encoded access list, gas used and access statistics.
+func (t *accessListTracer) GetResult() (json.RawMessage, error) {
+	result := accessListResult{
+		AccessList: types.AccessList{},
+		GasUsed:    hexutil.Uint64(t.gasUsed),
+	}
+	if t.err != nil {
+		result.Error = t.err.Error()
+	}
+	if t.inner != nil {
+		result.AccessList = t.inner.AccessList()
+		result.Stats = t.inner.Stats()
+	}
+	res, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *accessListTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
@@ -0,0 +1,100 @@
+package native_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v20/x/evm/core/logger"
+	"github.com/evmos/evmos/v20/x/evm/core/tracers"
+	_ "github.com/evmos/evmos/v20/x/evm/core/tracers/native"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessListTracer(t *testing.T) {
+	var (
+		from     = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		// address of the contract created by the CREATE below
+		created = crypto.CreateAddress(contract, 0)
+		slot    = common.BigToHash(big.NewInt(1))
+	)
+
+	// SLOAD(1), CREATE(0, 0, 0), BALANCE(created), STOP
+	code := common.Hex2Bytes("60015450" + "600060006000f050")
+	code = append(code, append(append([]byte{byte(vm.PUSH20)}, created.Bytes()...), byte(vm.BALANCE), byte(vm.POP))...)
+	code = append(code, byte(vm.STOP))
+
+	testCases := []struct {
+		name     string
+		acl      types.AccessList
+		expStats logger.AccessStats
+	}{
+		{
+			"slot accessed for the first time is cold",
+			nil,
+			logger.AccessStats{ColdAccounts: 0, WarmAccounts: 1, ColdSlots: 1, WarmSlots: 0},
+		},
+		{
+			"slot in the tx access list is warm",
+			types.AccessList{{Address: contract, StorageKeys: []common.Hash{slot}}},
+			logger.AccessStats{ColdAccounts: 0, WarmAccounts: 1, ColdSlots: 0, WarmSlots: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+			require.NoError(t, err)
+			statedb.CreateAccount(contract)
+			statedb.SetCode(contract, code)
+			statedb.Finalise(true)
+
+			rules := params.AllEthashProtocolChanges.Rules(big.NewInt(0), false)
+			statedb.PrepareAccessList(from, &contract, vm.DefaultActivePrecompiles(rules), tc.acl)
+
+			tracer, err := tracers.New("accessListTracer", &tracers.Context{AccessList: tc.acl}, nil)
+			require.NoError(t, err)
+
+			vmctx := vm.BlockContext{
+				CanTransfer: func(vm.StateDB, common.Address, *big.Int) bool { return true },
+				Transfer:    func(vm.StateDB, common.Address, common.Address, *big.Int) {},
+				BlockNumber: big.NewInt(0),
+			}
+			evm := vm.NewEVM(vmctx, vm.TxContext{}, statedb, params.AllEthashProtocolChanges, vm.Config{Debug: true, Tracer: tracer})
+
+			_, _, err = evm.Call(vm.AccountRef(from), contract, nil, 100_000, new(big.Int))
+			require.NoError(t, err)
+
+			res, err := tracer.GetResult()
+			require.NoError(t, err)
+
+			var result struct {
+				AccessList types.AccessList   `json:"accessList"`
+				Stats      logger.AccessStats `json:"stats"`
+			}
+			require.NoError(t, json.Unmarshal(res, &result))
+			require.Equal(t, tc.expStats, result.Stats)
+
+			// the created contract is warm and still part of the access list
+			require.Len(t, result.AccessList, 2)
+			for _, tuple := range result.AccessList {
+				switch tuple.Address {
+				case contract:
+					require.Equal(t, []common.Hash{slot}, tuple.StorageKeys)
+				case created:
+					require.Empty(t, tuple.StorageKeys)
+				default:
+					t.Fatalf("unexpected address in access list: %s", tuple.Address)
+				}
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 )
 
@@ -14,6 +15,9 @@ type Context struct {
 	BlockHash common.Hash // Hash of the block the tx is contained within (zero if dangling tx or call)
 	TxIndex   int         // Index of the transaction within a block (zero if dangling tx or call)
 	TxHash    common.Hash // Hash of the transaction being traced (zero if dangling call)
+	// AccessList is the EIP-2930 access list of the transaction being traced,
+	// which the EVM warms up before execution
+	AccessList types.AccessList
 }
 
 // Tracer interface extends vm.EVMLogger and additionally
@@ -22,6 +22,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethmath "github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
@@ -517,7 +518,20 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
 	}
 
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
+	var msg core.Message
+	if req.UnsignedCall {
+		msg, err = k.unsignedCallMessage(ctx, req.Msg, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else {
+		msg, err = tx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	result, _, err := k.traceTx(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
 	if err != nil {
 		// error will be returned with detail status from traceTx
 		return nil, err
@@ -584,7 +598,13 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i) // #nosec G115
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			result.Error = status.Error(codes.Internal, err.Error()).Error()
+			results = append(results, &result)
+			continue
+		}
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, msg, req.TraceConfig, true, nil)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -609,8 +629,7 @@ func (k *Keeper) traceTx(
 	ctx sdk.Context,
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
-	signer ethtypes.Signer,
-	tx *ethtypes.Transaction,
+	msg core.Message,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
@@ -622,10 +641,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -648,9 +663,10 @@ func (k *Keeper) traceTx(
 	tracer = logger.NewStructLogger(&logConfig)
 
 	tCtx := &tracers.Context{
-		BlockHash: txConfig.BlockHash,
-		TxIndex:   int(txConfig.TxIndex), //nolint:gosec
-		TxHash:    txConfig.TxHash,
+		BlockHash:  txConfig.BlockHash,
+		TxIndex:    int(txConfig.TxIndex), //nolint:gosec
+		TxHash:     txConfig.TxHash,
+		AccessList: msg.AccessList(),
 	}
 
 	if traceConfig.Tracer != "" {
@@ -694,6 +710,32 @@ func (k *Keeper) traceTx(
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
 }
 
+// unsignedCallMessage returns the core message of an unsigned transaction
+// traced as a simulated call (e.g. for eth_createAccessList). The sender is
+// taken from the msg From field and the nonce from the state at the traced
+// height, since the transaction carries no signature to recover them from.
+func (k *Keeper) unsignedCallMessage(
+	ctx sdk.Context,
+	ethMsg *types.MsgEthereumTx,
+	baseFee *big.Int,
+) (core.Message, error) {
+	if ethMsg.From == "" {
+		return nil, errors.New("sender address is required for unsigned calls")
+	}
+
+	tx := ethMsg.AsTransaction()
+	from := common.HexToAddress(ethMsg.From)
+	gasPrice := tx.GasPrice()
+	if baseFee != nil && tx.Type() == ethtypes.DynamicFeeTxType {
+		gasPrice = ethmath.BigMin(new(big.Int).Add(tx.GasTipCap(), baseFee), tx.GasFeeCap())
+	}
+
+	return ethtypes.NewMessage(
+		from, tx.To(), k.GetNonce(ctx, from), tx.Value(), tx.Gas(),
+		gasPrice, tx.GasFeeCap(), tx.GasTipCap(), tx.Data(), tx.AccessList(), true,
+	), nil
+}
+
 // BaseFee implements the Query/BaseFee gRPC method
 func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -724,3 +766,23 @@ func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.Q
 
 	return &types.QueryConfigResponse{Config: config}, nil
 }
+
+// StaticPrecompiles returns the name, version and ABI hash of the available static
+// precompiles, and whether they are enabled at the current height.
+func (k Keeper) StaticPrecompiles(c context.Context, _ *types.QueryStaticPrecompilesRequest) (*types.QueryStaticPrecompilesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	infos := k.GetStaticPrecompilesInfo(ctx)
+	precompiles := make([]types.StaticPrecompileInfo, len(infos))
+	for i, info := range infos {
+		precompiles[i] = types.StaticPrecompileInfo{
+			Address: info.Address.Hex(),
+			Name:    info.Name,
+			Version: info.Version,
+			AbiHash: info.ABIHash.Hex(),
+			Enabled: info.Enabled,
+		}
+	}
+
+	return &types.QueryStaticPrecompilesResponse{Precompiles: precompiles}, nil
+}
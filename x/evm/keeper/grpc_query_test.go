@@ -13,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	ethlogger "github.com/evmos/evmos/v20/x/evm/core/logger"
@@ -446,6 +447,25 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().Equal(expParams, res.Params)
 }
 
+func (suite *KeeperTestSuite) TestQueryStaticPrecompiles() {
+	ctx := suite.network.GetContext()
+
+	res, err := suite.network.GetEvmClient().StaticPrecompiles(ctx, &types.QueryStaticPrecompilesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Precompiles, len(types.AvailableStaticPrecompiles))
+
+	infos := suite.network.App.EvmKeeper.GetStaticPrecompilesInfo(ctx)
+	for i, info := range infos {
+		suite.Require().Equal(types.StaticPrecompileInfo{
+			Address: info.Address.Hex(),
+			Name:    info.Name,
+			Version: info.Version,
+			AbiHash: info.ABIHash.Hex(),
+			Enabled: info.Enabled,
+		}, res.Precompiles[i])
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryValidatorAccount() {
 	testCases := []struct {
 		msg           string
@@ -1224,6 +1244,117 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTraceTxUnsignedCall() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	hardcodedRecipient := common.HexToAddress("0xC6Fe5D33615a1C52c08018c47E8Bc53646A0E101")
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	suite.Require().NoError(err)
+
+	senderKey := suite.keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(senderKey, suite.factory)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.network.NextBlock())
+
+	transferData, err := erc20Contract.ABI.Pack("transfer", hardcodedRecipient, big.NewInt(1000))
+	suite.Require().NoError(err)
+
+	// access list returned by the previous successful trace, used as the tx
+	// access list of the next one as eth_createAccessList does
+	var prevAccessList ethtypes.AccessList
+
+	testCases := []struct {
+		msg            string
+		from           *common.Address
+		unsignedCall   bool
+		withAccessList bool
+		expPass        bool
+		expColdSlots   uint64
+	}{
+		{
+			"fail - unsigned tx without the unsigned call flag",
+			&senderKey.Addr,
+			false,
+			false,
+			false,
+			0,
+		},
+		{
+			"fail - unsigned call without sender",
+			nil,
+			true,
+			false,
+			false,
+			0,
+		},
+		{
+			"success - unsigned call",
+			&senderKey.Addr,
+			true,
+			false,
+			true,
+			2,
+		},
+		{
+			"success - unsigned call with the traced access list",
+			&senderKey.Addr,
+			true,
+			true,
+			true,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			gas := hexutil.Uint64(100_000)
+			data := hexutil.Bytes(transferData)
+			args := types.TransactionArgs{
+				From: tc.from,
+				To:   &contractAddr,
+				Gas:  &gas,
+				Data: &data,
+			}
+			if tc.withAccessList {
+				args.AccessList = &prevAccessList
+			}
+
+			traceReq := getDefaultTraceTxRequest(suite.network)
+			traceReq.Msg = args.ToTransaction()
+			traceReq.TraceConfig = &types.TraceConfig{Tracer: "accessListTracer"}
+			traceReq.UnsignedCall = tc.unsignedCall
+
+			res, err := suite.network.GetEvmClient().TraceTx(
+				suite.network.GetContext(),
+				&traceReq,
+			)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var result struct {
+				AccessList ethtypes.AccessList   `json:"accessList"`
+				Stats      ethlogger.AccessStats `json:"stats"`
+			}
+			suite.Require().NoError(json.Unmarshal(res.Data, &result))
+
+			// the balances of the sender and the recipient are read and updated
+			suite.Require().Len(result.AccessList, 1)
+			suite.Require().Equal(contractAddr, result.AccessList[0].Address)
+			suite.Require().Len(result.AccessList[0].StorageKeys, 2)
+			suite.Require().Equal(tc.expColdSlots, result.Stats.ColdSlots)
+			suite.Require().Positive(result.Stats.WarmSlots)
+
+			prevAccessList = result.AccessList
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceBlock() {
 	suite.enableFeemarket = true
 	defer func() { suite.enableFeemarket = false }()
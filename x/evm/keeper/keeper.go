@@ -3,6 +3,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
@@ -16,7 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v20/x/evm/core/logger"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/wrappers"
 
@@ -129,6 +130,28 @@ func (k Keeper) EmitBlockBloomEvent(ctx sdk.Context, bloom ethtypes.Bloom) {
 	)
 }
 
+// EmitTxAccessList emits the accounts and storage slots touched by the given
+// transaction, together with its cold and warm access statistics. The event is
+// used by the JSON-RPC to extend the transaction receipt.
+func (k Keeper) EmitTxAccessList(ctx sdk.Context, txHash string, tracer *logger.AccessListTracer) error {
+	value, err := json.Marshal(types.TxAccessList{
+		AccessList: tracer.AccessList(),
+		Stats:      tracer.Stats(),
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to encode access list")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTxAccessList,
+			sdk.NewAttribute(types.AttributeKeyEthereumTxHash, txHash),
+			sdk.NewAttribute(types.AttributeKeyTxAccessList, string(value)),
+		),
+	)
+	return nil
+}
+
 // GetAuthority returns the x/evm module authority address
 func (k Keeper) GetAuthority() sdk.AccAddress {
 	return k.authority
@@ -208,8 +231,8 @@ func (k Keeper) GetAccountStorage(ctx sdk.Context, address common.Address) types
 // ----------------------------------------------------------------------------
 
 // Tracer return a default vm.Tracer based on current keeper state
-func (k Keeper) Tracer(ctx sdk.Context, msg core.Message, ethCfg *params.ChainConfig) vm.EVMLogger {
-	return types.NewTracer(k.tracer, msg, ethCfg, ctx.BlockHeight())
+func (k Keeper) Tracer(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig) vm.EVMLogger {
+	return types.NewTracer(k.tracer, msg, cfg.ChainConfig, ctx.BlockHeight(), cfg.Params.GetActiveStaticPrecompilesAddrs())
 }
 
 // GetAccountWithoutBalance load nonce and codehash without balance,
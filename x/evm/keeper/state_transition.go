@@ -20,6 +20,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	evmoscore "github.com/evmos/evmos/v20/x/evm/core/core"
+	"github.com/evmos/evmos/v20/x/evm/core/logger"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 )
 
@@ -53,7 +54,7 @@ func (k *Keeper) NewEVM(
 
 	txCtx := evmoscore.NewEVMTxContext(msg)
 	if tracer == nil {
-		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
+		tracer = k.Tracer(ctx, msg, cfg)
 	}
 	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
 
@@ -166,8 +167,12 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	// thus restricted to be used only inside `ApplyMessage`.
 	tmpCtx, commit := ctx.CacheContext()
 
+	// the default tracer is created here so that the touched accounts and storage
+	// slots can be recorded when the node runs with the access list tracer.
+	tracer := k.Tracer(ctx, msg, cfg)
+
 	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, tracer, true, cfg, txConfig)
 	if err != nil {
 		// when a transaction contains multiple msg, as long as one of the msg fails
 		// all gas will be deducted. so is not msg.Gas()
@@ -175,6 +180,12 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
 
+	if accessListTracer, ok := tracer.(*logger.AccessListTracer); ok {
+		if err := k.EmitTxAccessList(ctx, res.Hash, accessListTracer); err != nil {
+			return nil, err
+		}
+	}
+
 	logs := types.LogsToEthereum(res.Logs)
 
 	// Compute block bloom filter
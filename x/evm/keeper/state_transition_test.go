@@ -3,6 +3,7 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
@@ -15,18 +16,22 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v20/precompiles/testutil/contracts"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmante "github.com/evmos/evmos/v20/x/evm/ante"
 	"github.com/evmos/evmos/v20/x/evm/keeper"
+	"github.com/evmos/evmos/v20/x/evm/keeper/testdata"
 	"github.com/evmos/evmos/v20/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
 )
@@ -581,7 +586,7 @@ func (suite *KeeperTestSuite) TestApplyMessage() {
 	tracer := suite.network.App.EvmKeeper.Tracer(
 		suite.network.GetContext(),
 		coreMsg,
-		config.ChainConfig,
+		config,
 	)
 	res, err := suite.network.App.EvmKeeper.ApplyMessage(
 		suite.network.GetContext(),
@@ -767,6 +772,180 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestApplyTransactionAccessListEvent() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	sender := suite.keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(sender, suite.factory)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.network.NextBlock())
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		tracer   string
+		expEvent bool
+	}{
+		{
+			"no event without the access list tracer",
+			"",
+			false,
+		},
+		{
+			"event emitted with the access list tracer",
+			types.TracerAccessList,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			app := suite.network.App
+			evmKeeper := keeper.NewKeeper(
+				app.AppCodec(), app.GetKey(types.StoreKey), app.GetTKey(types.TransientKey),
+				authtypes.NewModuleAddress(govtypes.ModuleName),
+				app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
+				&app.Erc20Keeper, tc.tracer, app.GetSubspace(types.ModuleName),
+			)
+
+			txArgs, err := suite.factory.GenerateContractCallArgs(
+				types.EvmTxArgs{To: &contractAddr},
+				factory.CallArgs{
+					ContractABI: erc20Contract.ABI,
+					MethodName:  "transfer",
+					Args:        []interface{}{suite.keyring.GetAddr(1), big.NewInt(1000)},
+				},
+			)
+			suite.Require().NoError(err)
+			signedTx, err := suite.factory.GenerateSignedEthTx(sender.Priv, txArgs)
+			suite.Require().NoError(err)
+			ethMsg, ok := signedTx.GetMsgs()[0].(*types.MsgEthereumTx)
+			suite.Require().True(ok)
+
+			ctx := suite.network.GetContext().WithEventManager(sdk.NewEventManager())
+			res, err := evmKeeper.ApplyTransaction(ctx, ethMsg.AsTransaction())
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed())
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeTxAccessList {
+					continue
+				}
+				found = true
+
+				txHash, ok := event.GetAttribute(types.AttributeKeyEthereumTxHash)
+				suite.Require().True(ok)
+				suite.Require().Equal(res.Hash, txHash.Value)
+
+				attr, ok := event.GetAttribute(types.AttributeKeyTxAccessList)
+				suite.Require().True(ok)
+
+				var accessList types.TxAccessList
+				suite.Require().NoError(json.Unmarshal([]byte(attr.Value), &accessList))
+				suite.Require().Len(accessList.AccessList, 1)
+				suite.Require().Equal(contractAddr, accessList.AccessList[0].Address)
+				suite.Require().Equal(uint64(2), accessList.Stats.ColdSlots)
+			}
+			suite.Require().Equal(tc.expEvent, found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplyTransactionAccessListEventPrecompileCall() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	sender := suite.keyring.GetKey(0)
+	distrCaller, err := contracts.LoadDistributionCallerContract()
+	suite.Require().NoError(err)
+	contractAddr, err := suite.factory.DeployContract(
+		sender.Priv,
+		types.EvmTxArgs{},
+		factory.ContractDeploymentData{Contract: distrCaller},
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.network.NextBlock())
+
+	app := suite.network.App
+	evmKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetTKey(types.TransientKey),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
+		&app.Erc20Keeper, types.TracerAccessList, app.GetSubspace(types.ModuleName),
+	)
+	evmKeeper.WithStaticPrecompiles(
+		keeper.NewAvailableStaticPrecompiles(
+			evmKeeper,
+			app.StakingKeeper,
+			app.DistrKeeper,
+			app.BankKeeper,
+			app.Erc20Keeper,
+			app.VestingKeeper,
+			app.AuthzKeeper,
+			app.TransferKeeper,
+			app.IBCKeeper.ChannelKeeper,
+			app.GovKeeper,
+		),
+	)
+
+	// the contract queries the distribution precompile
+	txArgs, err := suite.factory.GenerateContractCallArgs(
+		types.EvmTxArgs{To: &contractAddr},
+		factory.CallArgs{
+			ContractABI: distrCaller.ABI,
+			MethodName:  "getDelegatorWithdrawAddress",
+			Args:        []interface{}{sender.Addr},
+		},
+	)
+	suite.Require().NoError(err)
+	signedTx, err := suite.factory.GenerateSignedEthTx(sender.Priv, txArgs)
+	suite.Require().NoError(err)
+	ethMsg, ok := signedTx.GetMsgs()[0].(*types.MsgEthereumTx)
+	suite.Require().True(ok)
+
+	// build the execution context and fund the fee collector as the ante handler
+	// would, to run the precompile and refund the leftover gas
+	ctx := evmante.BuildEvmExecutionCtx(suite.network.GetContext()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager())
+	fees := sdk.NewCoins(sdk.NewCoin(types.GetEVMCoinDenom(), sdkmath.NewIntWithDecimal(1, 18)))
+	err = app.BankKeeper.SendCoinsFromAccountToModule(ctx, sender.AccAddr, authtypes.FeeCollectorName, fees)
+	suite.Require().NoError(err)
+
+	res, err := evmKeeper.ApplyTransaction(ctx, ethMsg.AsTransaction())
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeTxAccessList {
+			continue
+		}
+		found = true
+
+		attr, ok := event.GetAttribute(types.AttributeKeyTxAccessList)
+		suite.Require().True(ok)
+
+		var accessList types.TxAccessList
+		suite.Require().NoError(json.Unmarshal([]byte(attr.Value), &accessList))
+
+		// the active static precompiles are always warm and excluded from the list
+		distrAddr := common.HexToAddress(types.DistributionPrecompileAddress)
+		for _, tuple := range accessList.AccessList {
+			suite.Require().NotEqual(distrAddr, tuple.Address)
+		}
+		suite.Require().Zero(accessList.Stats.ColdAccounts)
+		suite.Require().Positive(accessList.Stats.WarmAccounts)
+	}
+	suite.Require().True(found)
+}
+
 func (suite *KeeperTestSuite) TestGetProposerAddress() {
 	suite.SetupTest()
 	address := sdk.ConsAddress(suite.keyring.GetAddr(0).Bytes())
@@ -8,6 +8,7 @@ import (
 	"maps"
 	"slices"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -20,6 +21,7 @@ import (
 	govprecompile "github.com/evmos/evmos/v20/precompiles/gov"
 	ics20precompile "github.com/evmos/evmos/v20/precompiles/ics20"
 	"github.com/evmos/evmos/v20/precompiles/p256"
+	registryprecompile "github.com/evmos/evmos/v20/precompiles/registry"
 	stakingprecompile "github.com/evmos/evmos/v20/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v20/precompiles/vesting"
 	erc20Keeper "github.com/evmos/evmos/v20/x/erc20/keeper"
@@ -35,6 +37,7 @@ const bech32PrecompileBaseGas = 6_000
 // AvailableStaticPrecompiles returns the list of all available static precompiled contracts.
 // NOTE: this should only be used during initialization of the Keeper.
 func NewAvailableStaticPrecompiles(
+	evmKeeper *Keeper,
 	stakingKeeper stakingkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
 	bankKeeper bankkeeper.Keeper,
@@ -95,6 +98,11 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate gov precompile: %w", err))
 	}
 
+	registryPrecompile, err := registryprecompile.NewPrecompile(evmKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate registry precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -106,6 +114,7 @@ func NewAvailableStaticPrecompiles(
 	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[bankPrecompile.Address()] = bankPrecompile
 	precompiles[govPrecompile.Address()] = govPrecompile
+	precompiles[registryPrecompile.Address()] = registryPrecompile
 	return precompiles
 }
 
@@ -144,3 +153,31 @@ func (k Keeper) IsAvailableStaticPrecompile(params *types.Params, address common
 	return slices.Contains(params.ActiveStaticPrecompiles, address.String()) ||
 		slices.Contains(vm.PrecompiledAddressesBerlin, address)
 }
+
+// GetStaticPrecompilesInfo returns the name, version and ABI hash of the available
+// static precompiles, and whether they are enabled at the current height.
+func (k *Keeper) GetStaticPrecompilesInfo(ctx sdk.Context) []types.PrecompileInfo {
+	params := k.GetParams(ctx)
+
+	infos := make([]types.PrecompileInfo, 0, len(types.AvailableStaticPrecompiles))
+	for _, hexAddr := range types.AvailableStaticPrecompiles {
+		address := common.HexToAddress(hexAddr)
+		metadata := types.StaticPrecompilesMetadata[hexAddr]
+
+		info := types.PrecompileInfo{
+			Address: address,
+			Name:    metadata.Name,
+			Version: metadata.Version,
+			Enabled: k.IsAvailableStaticPrecompile(&params, address),
+		}
+
+		// NOTE: precompiles without an ABI (e.g. p256) have an empty hash
+		if precompile, ok := k.precompiles[address].(interface{ ABIHash() common.Hash }); ok {
+			info.ABIHash = precompile.ABIHash()
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestGetStaticPrecompilesInfo() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	// disable the gov precompile
+	params := suite.network.App.EvmKeeper.GetParams(ctx)
+	params.ActiveStaticPrecompiles = slices.DeleteFunc(params.ActiveStaticPrecompiles, func(address string) bool {
+		return address == types.GovPrecompileAddress
+	})
+	suite.Require().NoError(suite.network.App.EvmKeeper.SetParams(ctx, params))
+
+	infos := suite.network.App.EvmKeeper.GetStaticPrecompilesInfo(ctx)
+	suite.Require().Len(infos, len(types.AvailableStaticPrecompiles))
+
+	for i, info := range infos {
+		hexAddr := types.AvailableStaticPrecompiles[i]
+		suite.Require().Equal(common.HexToAddress(hexAddr), info.Address)
+		suite.Require().Equal(types.StaticPrecompilesMetadata[hexAddr].Name, info.Name)
+		suite.Require().Equal(types.StaticPrecompilesMetadata[hexAddr].Version, info.Version)
+		suite.Require().Equal(hexAddr != types.GovPrecompileAddress, info.Enabled, hexAddr)
+
+		if hexAddr == types.P256PrecompileAddress {
+			suite.Require().Equal(common.Hash{}, info.ABIHash, "expected empty ABI hash for p256")
+		} else {
+			suite.Require().NotEqual(common.Hash{}, info.ABIHash, hexAddr)
+		}
+	}
+
+	// the ABI hashes are stable across calls
+	suite.Require().Equal(infos, suite.network.App.EvmKeeper.GetStaticPrecompilesInfo(ctx))
+}
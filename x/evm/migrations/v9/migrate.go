@@ -0,0 +1,47 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v9
+
+import (
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/utils"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// MigrateStore migrates the x/evm module state from the consensus version 8 to
+// version 9. It enables the registry precompile, which is part of the default
+// active static precompiles for new chains.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+
+	store := ctx.KVStore(storeKey)
+
+	paramsBz := store.Get(types.KeyPrefixParams)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	if slices.Contains(params.ActiveStaticPrecompiles, types.RegistryPrecompileAddress) {
+		return nil
+	}
+
+	params.ActiveStaticPrecompiles = append(params.ActiveStaticPrecompiles, types.RegistryPrecompileAddress)
+	utils.SortSlice(params.ActiveStaticPrecompiles)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+
+	store.Set(types.KeyPrefixParams, bz)
+	return nil
+}
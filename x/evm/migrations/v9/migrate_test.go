@@ -0,0 +1,62 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v9_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/encoding"
+	v9 "github.com/evmos/evmos/v20/x/evm/migrations/v9"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		precompiles    []string
+		expPrecompiles []string
+	}{
+		{
+			"registry precompile disabled",
+			[]string{types.StakingPrecompileAddress, types.GovPrecompileAddress},
+			[]string{types.StakingPrecompileAddress, types.GovPrecompileAddress, types.RegistryPrecompileAddress},
+		},
+		{
+			"registry precompile already enabled",
+			[]string{types.StakingPrecompileAddress, types.RegistryPrecompileAddress},
+			[]string{types.StakingPrecompileAddress, types.RegistryPrecompileAddress},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encCfg := encoding.MakeConfig()
+			cdc := encCfg.Codec
+
+			storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+			tKey := storetypes.NewTransientStoreKey("transient_test")
+			ctx := testutil.DefaultContext(storeKey, tKey)
+			kvStore := ctx.KVStore(storeKey)
+
+			// Create a pre migration environment without the registry precompile.
+			paramsV8 := types.DefaultParams()
+			paramsV8.ActiveStaticPrecompiles = tc.precompiles
+			kvStore.Set(types.KeyPrefixParams, cdc.MustMarshal(&paramsV8))
+
+			err := v9.MigrateStore(ctx, storeKey, cdc)
+			require.NoError(t, err)
+
+			paramsBz := kvStore.Get(types.KeyPrefixParams)
+			var params types.Params
+			cdc.MustUnmarshal(paramsBz, &params)
+
+			require.Equal(t, tc.expPrecompiles, params.ActiveStaticPrecompiles)
+			require.NoError(t, params.Validate())
+		})
+	}
+}
@@ -8,6 +8,8 @@ const (
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
 	EventTypeFeeMarket  = "evm_fee_market"
+	// EventTypeTxAccessList is only emitted when the node runs with the access_list tracer
+	EventTypeTxAccessList = "tx_access_list"
 
 	AttributeKeyBaseFee         = "base_fee"
 	AttributeKeyContractAddress = "contract"
@@ -18,6 +20,7 @@ const (
 	AttributeKeyTxGasUsed       = "txGasUsed"
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
+	AttributeKeyTxAccessList    = "txAccessList"
 
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
@@ -3,6 +3,8 @@
 
 package types
 
+import "github.com/ethereum/go-ethereum/common"
+
 const (
 	P256PrecompileAddress   = "0x0000000000000000000000000000000000000100"
 	Bech32PrecompileAddress = "0x0000000000000000000000000000000000000400"
@@ -15,6 +17,7 @@ const (
 	VestingPrecompileAddress      = "0x0000000000000000000000000000000000000803"
 	BankPrecompileAddress         = "0x0000000000000000000000000000000000000804"
 	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
+	RegistryPrecompileAddress     = "0x0000000000000000000000000000000000000806"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -30,4 +33,39 @@ var AvailableStaticPrecompiles = []string{
 	VestingPrecompileAddress,
 	BankPrecompileAddress,
 	GovPrecompileAddress,
+	RegistryPrecompileAddress,
+}
+
+// StaticPrecompileMetadata defines the name and the interface version of a static precompile.
+type StaticPrecompileMetadata struct {
+	Name    string
+	Version uint64
+}
+
+// StaticPrecompilesMetadata defines the metadata of the available static precompiles,
+// indexed by address.
+//
+// NOTE: The version of a precompile must be bumped every time its interface or
+// behavior changes, so that contracts can detect the supported features.
+var StaticPrecompilesMetadata = map[string]StaticPrecompileMetadata{
+	P256PrecompileAddress:         {Name: "p256", Version: 1},
+	Bech32PrecompileAddress:       {Name: "bech32", Version: 1},
+	StakingPrecompileAddress:      {Name: "staking", Version: 1},
+	DistributionPrecompileAddress: {Name: "distribution", Version: 1},
+	ICS20PrecompileAddress:        {Name: "ics20", Version: 1},
+	VestingPrecompileAddress:      {Name: "vesting", Version: 1},
+	BankPrecompileAddress:         {Name: "bank", Version: 1},
+	GovPrecompileAddress:          {Name: "gov", Version: 1},
+	RegistryPrecompileAddress:     {Name: "registry", Version: 1},
+}
+
+// PrecompileInfo defines the information of a static precompile at a given height.
+type PrecompileInfo struct {
+	Address common.Address
+	Name    string
+	Version uint64
+	// ABIHash is the hash of the precompile ABI. It is empty for the precompiles
+	// without an ABI.
+	ABIHash common.Hash
+	Enabled bool
 }
@@ -947,6 +947,9 @@ type QueryTraceTxRequest struct {
 	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	// block_max_gas of the block of the requested transaction
 	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	// unsigned_call defines that msg is an unsigned transaction simulated as a
+	// call from msg.from (e.g. for eth_createAccessList) instead of a signed one.
+	UnsignedCall bool `protobuf:"varint,11,opt,name=unsigned_call,json=unsignedCall,proto3" json:"unsigned_call,omitempty"`
 }
 
 func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
@@ -1045,6 +1048,13 @@ func (m *QueryTraceTxRequest) GetBlockMaxGas() int64 {
 	return 0
 }
 
+func (m *QueryTraceTxRequest) GetUnsignedCall() bool {
+	if m != nil {
+		return m.UnsignedCall
+	}
+	return false
+}
+
 // QueryTraceTxResponse defines TraceTx response
 type QueryTraceTxResponse struct {
 	// data is the response serialized in bytes
@@ -1482,6 +1492,172 @@ func (m *QueryConfigResponse) GetConfig() *ChainConfig {
 	return nil
 }
 
+// QueryStaticPrecompilesRequest defines the request type for querying the static precompiles
+type QueryStaticPrecompilesRequest struct {
+}
+
+func (m *QueryStaticPrecompilesRequest) Reset()         { *m = QueryStaticPrecompilesRequest{} }
+func (m *QueryStaticPrecompilesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStaticPrecompilesRequest) ProtoMessage()    {}
+func (*QueryStaticPrecompilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{28}
+}
+func (m *QueryStaticPrecompilesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStaticPrecompilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStaticPrecompilesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStaticPrecompilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaticPrecompilesRequest.Merge(m, src)
+}
+func (m *QueryStaticPrecompilesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStaticPrecompilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaticPrecompilesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStaticPrecompilesRequest proto.InternalMessageInfo
+
+// StaticPrecompileInfo defines the information of a static precompile at the current height.
+type StaticPrecompileInfo struct {
+	// address is the hex address of the precompile
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// name is the name of the precompile
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the interface version of the precompile
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// abi_hash is the hex hash of the precompile ABI. It is the zero hash for the
+	// precompiles without an ABI.
+	AbiHash string `protobuf:"bytes,4,opt,name=abi_hash,json=abiHash,proto3" json:"abi_hash,omitempty"`
+	// enabled defines if the precompile is enabled at the current height
+	Enabled bool `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *StaticPrecompileInfo) Reset()         { *m = StaticPrecompileInfo{} }
+func (m *StaticPrecompileInfo) String() string { return proto.CompactTextString(m) }
+func (*StaticPrecompileInfo) ProtoMessage()    {}
+func (*StaticPrecompileInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{29}
+}
+func (m *StaticPrecompileInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StaticPrecompileInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StaticPrecompileInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StaticPrecompileInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaticPrecompileInfo.Merge(m, src)
+}
+func (m *StaticPrecompileInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *StaticPrecompileInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaticPrecompileInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StaticPrecompileInfo proto.InternalMessageInfo
+
+func (m *StaticPrecompileInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StaticPrecompileInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StaticPrecompileInfo) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *StaticPrecompileInfo) GetAbiHash() string {
+	if m != nil {
+		return m.AbiHash
+	}
+	return ""
+}
+
+func (m *StaticPrecompileInfo) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// QueryStaticPrecompilesResponse returns the information of the static precompiles.
+type QueryStaticPrecompilesResponse struct {
+	// precompiles is the information of the available static precompiles
+	Precompiles []StaticPrecompileInfo `protobuf:"bytes,1,rep,name=precompiles,proto3" json:"precompiles"`
+}
+
+func (m *QueryStaticPrecompilesResponse) Reset()         { *m = QueryStaticPrecompilesResponse{} }
+func (m *QueryStaticPrecompilesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStaticPrecompilesResponse) ProtoMessage()    {}
+func (*QueryStaticPrecompilesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{30}
+}
+func (m *QueryStaticPrecompilesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStaticPrecompilesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStaticPrecompilesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStaticPrecompilesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaticPrecompilesResponse.Merge(m, src)
+}
+func (m *QueryStaticPrecompilesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStaticPrecompilesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaticPrecompilesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStaticPrecompilesResponse proto.InternalMessageInfo
+
+func (m *QueryStaticPrecompilesResponse) GetPrecompiles() []StaticPrecompileInfo {
+	if m != nil {
+		return m.Precompiles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
@@ -1511,115 +1687,127 @@ func init() {
 	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "ethermint.evm.v1.QueryGlobalMinGasPriceResponse")
 	proto.RegisterType((*QueryConfigRequest)(nil), "ethermint.evm.v1.QueryConfigRequest")
 	proto.RegisterType((*QueryConfigResponse)(nil), "ethermint.evm.v1.QueryConfigResponse")
+	proto.RegisterType((*QueryStaticPrecompilesRequest)(nil), "ethermint.evm.v1.QueryStaticPrecompilesRequest")
+	proto.RegisterType((*StaticPrecompileInfo)(nil), "ethermint.evm.v1.StaticPrecompileInfo")
+	proto.RegisterType((*QueryStaticPrecompilesResponse)(nil), "ethermint.evm.v1.QueryStaticPrecompilesResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x13, 0x47,
-	0x1b, 0xce, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x08, 0x43, 0x00, 0xc7, 0x24, 0x76, 0x58, 0xc8, 0x0f,
-	0xf8, 0x60, 0x37, 0xc9, 0xf7, 0x7d, 0x48, 0xdf, 0xd7, 0x43, 0x49, 0xa2, 0x10, 0x28, 0x50, 0xd1,
-	0x6d, 0xd4, 0x43, 0xa5, 0xca, 0x1a, 0xaf, 0x87, 0xf5, 0x2a, 0xde, 0x1d, 0xb3, 0x33, 0xb6, 0x1c,
-	0x10, 0x87, 0xa2, 0xaa, 0x2d, 0xea, 0x05, 0xa9, 0xb7, 0xf6, 0xc2, 0xb1, 0x52, 0x2f, 0xbd, 0xf1,
-	0x2f, 0x70, 0x44, 0xed, 0xa5, 0xea, 0x81, 0x56, 0x50, 0xa9, 0xfd, 0x1b, 0x7a, 0xaa, 0xe6, 0xc7,
-	0xda, 0xbb, 0xb6, 0x37, 0x0e, 0x15, 0xbd, 0xf5, 0x92, 0xec, 0xcc, 0xbc, 0xef, 0xfb, 0x3c, 0xf3,
-	0xce, 0x3b, 0xf3, 0x3e, 0x06, 0x73, 0x98, 0xd5, 0x70, 0xe0, 0xb9, 0x3e, 0x33, 0x71, 0xcb, 0x33,
-	0x5b, 0x6b, 0xe6, 0xdd, 0x26, 0x0e, 0xf6, 0x8d, 0x46, 0x40, 0x18, 0x81, 0xd3, 0x9d, 0x55, 0x03,
-	0xb7, 0x3c, 0xa3, 0xb5, 0x56, 0x38, 0x86, 0x3c, 0xd7, 0x27, 0xa6, 0xf8, 0x2b, 0x8d, 0x0a, 0x17,
-	0x6c, 0x42, 0x3d, 0x42, 0xcd, 0x0a, 0xa2, 0x58, 0x7a, 0x9b, 0xad, 0xb5, 0x0a, 0x66, 0x68, 0xcd,
-	0x6c, 0x20, 0xc7, 0xf5, 0x11, 0x73, 0x89, 0xaf, 0x6c, 0x0b, 0x7d, 0x70, 0x3c, 0xae, 0x5c, 0x9b,
-	0xed, 0x5b, 0x63, 0x6d, 0xb5, 0x34, 0xe3, 0x10, 0x87, 0x88, 0x4f, 0x93, 0x7f, 0xa9, 0xd9, 0x39,
-	0x87, 0x10, 0xa7, 0x8e, 0x4d, 0xd4, 0x70, 0x4d, 0xe4, 0xfb, 0x84, 0x09, 0x24, 0xaa, 0x56, 0x4b,
-	0x6a, 0x55, 0x8c, 0x2a, 0xcd, 0x3b, 0x26, 0x73, 0x3d, 0x4c, 0x19, 0xf2, 0x1a, 0xd2, 0x40, 0xff,
-	0x1f, 0x38, 0xfe, 0x1e, 0x67, 0xbb, 0x61, 0xdb, 0xa4, 0xe9, 0x33, 0x0b, 0xdf, 0x6d, 0x62, 0xca,
-	0x60, 0x1e, 0x64, 0x50, 0xb5, 0x1a, 0x60, 0x4a, 0xf3, 0xda, 0x82, 0xb6, 0x32, 0x61, 0x85, 0xc3,
-	0xff, 0x67, 0x3f, 0x7f, 0x52, 0x1a, 0xf9, 0xfd, 0x49, 0x69, 0x44, 0xb7, 0xc1, 0x4c, 0xdc, 0x95,
-	0x36, 0x88, 0x4f, 0x31, 0xf7, 0xad, 0xa0, 0x3a, 0xf2, 0x6d, 0x1c, 0xfa, 0xaa, 0x21, 0x3c, 0x0d,
-	0x26, 0x6c, 0x52, 0xc5, 0xe5, 0x1a, 0xa2, 0xb5, 0xfc, 0xa8, 0x58, 0xcb, 0xf2, 0x89, 0x6b, 0x88,
-	0xd6, 0xe0, 0x0c, 0x18, 0xf3, 0x09, 0x77, 0x4a, 0x2d, 0x68, 0x2b, 0x69, 0x4b, 0x0e, 0xf4, 0xb7,
-	0xc1, 0xac, 0x00, 0xd9, 0x12, 0xe9, 0xfd, 0x0b, 0x2c, 0x3f, 0xd5, 0x40, 0x61, 0x50, 0x04, 0x45,
-	0x76, 0x11, 0x1c, 0x91, 0x27, 0x57, 0x8e, 0x47, 0x9a, 0x92, 0xb3, 0x1b, 0x72, 0x12, 0x16, 0x40,
-	0x96, 0x72, 0x50, 0xce, 0x6f, 0x54, 0xf0, 0xeb, 0x8c, 0x79, 0x08, 0x24, 0xa3, 0x96, 0xfd, 0xa6,
-	0x57, 0xc1, 0x81, 0xda, 0xc1, 0x94, 0x9a, 0x7d, 0x57, 0x4c, 0xea, 0x37, 0xc0, 0x9c, 0xe0, 0xf1,
-	0x01, 0xaa, 0xbb, 0x55, 0xc4, 0x48, 0xd0, 0xb3, 0x99, 0x33, 0x60, 0xd2, 0x26, 0x7e, 0x2f, 0x8f,
-	0x1c, 0x9f, 0xdb, 0xe8, 0xdb, 0xd5, 0x17, 0x1a, 0x98, 0x4f, 0x88, 0xa6, 0x36, 0xb6, 0x0c, 0x8e,
-	0x86, 0xac, 0xe2, 0x11, 0x43, 0xb2, 0x6f, 0x70, 0x6b, 0x61, 0x11, 0x6d, 0xca, 0x73, 0x7e, 0x9d,
-	0xe3, 0x59, 0x55, 0x45, 0xd4, 0x71, 0x1d, 0x56, 0x44, 0xfa, 0x0d, 0x05, 0xf6, 0x3e, 0x23, 0x01,
-	0x72, 0x86, 0x83, 0xc1, 0x69, 0x90, 0xda, 0xc3, 0xfb, 0xaa, 0xde, 0xf8, 0x67, 0x04, 0xfe, 0xa2,
-	0x82, 0xef, 0x04, 0x53, 0xf0, 0x33, 0x60, 0xac, 0x85, 0xea, 0xcd, 0x10, 0x5c, 0x0e, 0xf4, 0xcb,
-	0x60, 0x5a, 0x95, 0x52, 0xf5, 0xb5, 0x36, 0xb9, 0x0c, 0x8e, 0x45, 0xfc, 0x14, 0x04, 0x04, 0x69,
-	0x5e, 0xfb, 0xc2, 0x6b, 0xd2, 0x12, 0xdf, 0xfa, 0x3d, 0x00, 0x85, 0xe1, 0x6e, 0xfb, 0x26, 0x71,
-	0x68, 0x08, 0x01, 0x41, 0x5a, 0xdc, 0x18, 0x19, 0x5f, 0x7c, 0xc3, 0xab, 0x00, 0x74, 0xdf, 0x15,
-	0xb1, 0xb7, 0xdc, 0xfa, 0x92, 0x21, 0x8b, 0xd6, 0xe0, 0x8f, 0x90, 0x21, 0x9f, 0x30, 0xf5, 0x08,
-	0x19, 0xb7, 0xbb, 0xa9, 0xb2, 0x22, 0x9e, 0x11, 0x92, 0x8f, 0x34, 0x95, 0xd8, 0x10, 0x5c, 0xf1,
-	0x3c, 0x0f, 0xd2, 0x75, 0xe2, 0xf0, 0xdd, 0xa5, 0x56, 0x72, 0xeb, 0x27, 0x8c, 0xde, 0xd7, 0xd0,
-	0xb8, 0x49, 0x1c, 0x4b, 0x98, 0xc0, 0x9d, 0x01, 0xa4, 0x96, 0x87, 0x92, 0x92, 0x38, 0x51, 0x56,
-	0xfa, 0x8c, 0xca, 0xc3, 0x6d, 0x14, 0x20, 0x2f, 0xcc, 0x83, 0x6e, 0x29, 0x82, 0xe1, 0xac, 0x22,
-	0xf8, 0x16, 0x18, 0x6f, 0x88, 0x19, 0x91, 0xa0, 0xdc, 0x7a, 0xbe, 0x9f, 0xa2, 0xf4, 0xd8, 0x9c,
-	0x78, 0xf6, 0xa2, 0x34, 0xf2, 0xcd, 0x6f, 0xdf, 0x5d, 0xd0, 0x2c, 0xe5, 0xa2, 0x3f, 0xd5, 0xc0,
-	0x91, 0x6d, 0x56, 0xdb, 0x42, 0xf5, 0x7a, 0x24, 0xdd, 0x28, 0x70, 0x68, 0x78, 0x30, 0xfc, 0x1b,
-	0x9e, 0x02, 0x19, 0x07, 0xd1, 0xb2, 0x8d, 0x1a, 0xea, 0x8e, 0x8c, 0x3b, 0x88, 0x6e, 0xa1, 0x06,
-	0xfc, 0x08, 0x4c, 0x37, 0x02, 0xd2, 0x20, 0x14, 0x07, 0x9d, 0x7b, 0xc6, 0xef, 0xc8, 0xe4, 0xe6,
-	0xfa, 0x1f, 0x2f, 0x4a, 0x86, 0xe3, 0xb2, 0x5a, 0xb3, 0x62, 0xd8, 0xc4, 0x33, 0x55, 0x83, 0x90,
-	0xff, 0x2e, 0xd1, 0xea, 0x9e, 0xc9, 0xf6, 0x1b, 0x98, 0x1a, 0x5b, 0xdd, 0x0b, 0x6e, 0x1d, 0x0d,
-	0x63, 0x85, 0x97, 0x73, 0x16, 0x64, 0xed, 0x1a, 0x72, 0xfd, 0xb2, 0x5b, 0xcd, 0xa7, 0x17, 0xb4,
-	0x95, 0x94, 0x95, 0x11, 0xe3, 0xeb, 0x55, 0x7d, 0x17, 0x1c, 0xdf, 0xa6, 0xcc, 0xf5, 0x10, 0xc3,
-	0x3b, 0xa8, 0x9b, 0x8d, 0x69, 0x90, 0x72, 0x90, 0x24, 0x9f, 0xb6, 0xf8, 0x27, 0x9f, 0x09, 0x30,
-	0x13, 0xbc, 0x27, 0x2d, 0xfe, 0xc9, 0xa3, 0xb6, 0xbc, 0x32, 0x0e, 0x02, 0x22, 0x2f, 0xf4, 0x84,
-	0x95, 0x69, 0x79, 0xdb, 0x7c, 0xa8, 0x3f, 0x4a, 0x87, 0x55, 0x10, 0x20, 0x1b, 0xef, 0xb6, 0xc3,
-	0xa4, 0xac, 0x81, 0x94, 0x47, 0x1d, 0x95, 0xe1, 0x52, 0x7f, 0x86, 0x6f, 0x51, 0x67, 0x9b, 0xcf,
-	0xe1, 0xa6, 0xb7, 0xdb, 0xb6, 0xb8, 0x2d, 0xbc, 0x02, 0x26, 0x19, 0x0f, 0x52, 0xb6, 0x89, 0x7f,
-	0xc7, 0x75, 0x04, 0x52, 0x6e, 0x7d, 0xbe, 0xdf, 0x57, 0x40, 0x6d, 0x09, 0x23, 0x2b, 0xc7, 0xba,
-	0x03, 0xb8, 0x05, 0x26, 0x1b, 0x01, 0xae, 0x62, 0x1b, 0x53, 0x4a, 0x02, 0x9a, 0x4f, 0x8b, 0x12,
-	0x1c, 0x8a, 0x1e, 0x73, 0xe2, 0xef, 0x6a, 0xa5, 0x4e, 0xec, 0xbd, 0xf0, 0x05, 0x1b, 0x13, 0x69,
-	0xcc, 0x89, 0x39, 0xf9, 0x7e, 0xc1, 0x79, 0x00, 0xa4, 0x89, 0xb8, 0x66, 0xe3, 0x22, 0x23, 0x13,
-	0x62, 0x46, 0x74, 0xa6, 0x6b, 0xe1, 0x32, 0x6f, 0x9e, 0xf9, 0x8c, 0xd8, 0x46, 0xc1, 0x90, 0x9d,
-	0xd5, 0x08, 0x3b, 0xab, 0xb1, 0x1b, 0x76, 0xd6, 0xcd, 0x29, 0x5e, 0x66, 0x8f, 0x7f, 0x2e, 0x69,
-	0xb2, 0xd4, 0x64, 0x24, 0xbe, 0x3c, 0xb0, 0x5a, 0xb2, 0x7f, 0x4f, 0xb5, 0x4c, 0xc4, 0xaa, 0x05,
-	0xea, 0x60, 0x4a, 0xee, 0xc1, 0x43, 0xed, 0x32, 0x2f, 0x10, 0x10, 0x49, 0xc3, 0x2d, 0xd4, 0xde,
-	0x41, 0xf4, 0x9d, 0x74, 0x76, 0x74, 0x3a, 0x65, 0x65, 0x59, 0xbb, 0xec, 0xfa, 0x55, 0xdc, 0xd6,
-	0x2f, 0xa8, 0xc7, 0xb1, 0x53, 0x0a, 0xdd, 0x97, 0xab, 0x8a, 0x18, 0x0a, 0x2f, 0x08, 0xff, 0xd6,
-	0x9f, 0xa6, 0xc0, 0xc9, 0xae, 0xf1, 0x26, 0x8f, 0x1a, 0x29, 0x1d, 0xd6, 0x0e, 0xdf, 0x8f, 0xe1,
-	0xa5, 0xc3, 0xda, 0xf4, 0x0d, 0x94, 0xce, 0x3f, 0xa7, 0x7e, 0xc8, 0x53, 0xd7, 0x2f, 0x81, 0x53,
-	0x7d, 0x07, 0x77, 0xc0, 0x41, 0x9f, 0xe8, 0xf4, 0x7a, 0x8a, 0xaf, 0xe2, 0xb0, 0xa7, 0xe8, 0x37,
-	0x3b, 0x7d, 0x5c, 0x4d, 0xab, 0x10, 0xff, 0x01, 0x59, 0xfe, 0xf0, 0x97, 0xef, 0x60, 0xd5, 0x4b,
-	0x37, 0x67, 0x7f, 0x7a, 0x51, 0x3a, 0x21, 0x77, 0x48, 0xab, 0x7b, 0x86, 0x4b, 0x4c, 0x0f, 0xb1,
-	0x9a, 0x71, 0xdd, 0x67, 0xbc, 0xc7, 0x0b, 0x6f, 0xbd, 0xa4, 0xd4, 0xcd, 0x4e, 0x9d, 0x54, 0x50,
-	0xfd, 0x96, 0xeb, 0xef, 0x20, 0x7a, 0x3b, 0x70, 0x3b, 0xd2, 0x42, 0xb7, 0x41, 0x31, 0xc9, 0x40,
-	0x01, 0x6f, 0x80, 0x29, 0xcf, 0xf5, 0xf9, 0xa6, 0xcb, 0x0d, 0xbe, 0xa0, 0xd0, 0xe7, 0xf9, 0x29,
-	0x25, 0x33, 0xc8, 0x79, 0xdd, 0x50, 0x9d, 0x2e, 0xa4, 0xea, 0xab, 0xb3, 0xd3, 0xe3, 0xb1, 0x59,
-	0x85, 0xf7, 0x5f, 0x30, 0xae, 0x8a, 0x55, 0x4b, 0x2a, 0xd6, 0x2d, 0x7e, 0x2a, 0xca, 0x4d, 0x19,
-	0xaf, 0x7f, 0x7f, 0x04, 0x8c, 0x89, 0x70, 0xf0, 0x63, 0x0d, 0x64, 0x94, 0x88, 0x83, 0x8b, 0xfd,
-	0xce, 0x03, 0x54, 0x7a, 0x61, 0x69, 0x98, 0x99, 0xe4, 0xa6, 0x2f, 0x3f, 0xfc, 0xe1, 0xd7, 0x2f,
-	0x47, 0xcf, 0xc0, 0x12, 0xff, 0x4d, 0x41, 0x68, 0xf8, 0xcb, 0x42, 0x89, 0x38, 0xf3, 0xbe, 0x2a,
-	0xca, 0x07, 0xf0, 0x2b, 0x0d, 0x4c, 0xc5, 0x74, 0x32, 0xfc, 0x57, 0x02, 0xc4, 0x20, 0x3d, 0x5e,
-	0xb8, 0x78, 0x38, 0x63, 0xc5, 0xca, 0x10, 0xac, 0x56, 0xe0, 0x52, 0x9c, 0x55, 0x28, 0xc7, 0xfb,
-	0xc8, 0x7d, 0xab, 0x81, 0xe9, 0x5e, 0xb9, 0x0b, 0x8d, 0x04, 0xc8, 0x04, 0x95, 0x5d, 0x30, 0x0f,
-	0x6d, 0xaf, 0x58, 0x5e, 0x16, 0x2c, 0x57, 0xa1, 0x11, 0x67, 0xd9, 0x0a, 0xed, 0xbb, 0x44, 0xa3,
-	0xea, 0xfd, 0x01, 0x7c, 0xa8, 0x81, 0x8c, 0x12, 0xb5, 0x89, 0xc7, 0x19, 0xd7, 0xcb, 0x89, 0xc7,
-	0xd9, 0xa3, 0x8d, 0xf5, 0x15, 0x41, 0x49, 0x87, 0x0b, 0x71, 0x4a, 0x4a, 0x20, 0xd3, 0x48, 0xca,
-	0x3e, 0xd3, 0x40, 0x46, 0x49, 0xdb, 0x44, 0x12, 0x71, 0x1d, 0x9d, 0x48, 0xa2, 0x47, 0x21, 0xeb,
-	0x97, 0x04, 0x89, 0x65, 0xb8, 0x18, 0x27, 0x41, 0xa5, 0x59, 0x97, 0x83, 0x79, 0x7f, 0x0f, 0xef,
-	0x3f, 0x80, 0x2d, 0x90, 0xe6, 0xea, 0x17, 0xea, 0x89, 0x25, 0xd2, 0x91, 0xd4, 0x85, 0xb3, 0x07,
-	0xda, 0x28, 0xfc, 0x45, 0x81, 0x5f, 0x82, 0xf3, 0xbd, 0xd5, 0x53, 0x8d, 0x65, 0x80, 0x82, 0x71,
-	0x29, 0xfe, 0xe0, 0xb9, 0x84, 0xa8, 0x31, 0x8d, 0x59, 0x58, 0x1c, 0x62, 0xa5, 0xd0, 0xe7, 0x04,
-	0xfa, 0x49, 0x38, 0x13, 0x47, 0x97, 0xa2, 0x12, 0x32, 0x90, 0x51, 0x9a, 0x12, 0x2e, 0xf4, 0xc7,
-	0x8b, 0xcb, 0xcd, 0xc2, 0xf2, 0xb0, 0x8e, 0x18, 0x62, 0x16, 0x05, 0x66, 0x1e, 0x9e, 0x8c, 0x63,
-	0x62, 0x56, 0x2b, 0xdb, 0x1c, 0xea, 0x1e, 0xc8, 0x45, 0x04, 0xe1, 0x21, 0x90, 0x07, 0xec, 0x75,
-	0x80, 0xa2, 0xd4, 0x75, 0x81, 0x3b, 0x07, 0x0b, 0x3d, 0xb8, 0xca, 0x94, 0x3f, 0xb1, 0xb0, 0x0d,
-	0x32, 0x4a, 0x25, 0x24, 0xd6, 0x59, 0x5c, 0x50, 0x26, 0xd6, 0x59, 0x8f, 0xd8, 0x48, 0xda, 0xb5,
-	0x94, 0x07, 0xac, 0x0d, 0x3f, 0xd1, 0x00, 0xe8, 0xb6, 0x2e, 0xb8, 0x72, 0x50, 0xd8, 0xa8, 0x2c,
-	0x29, 0x9c, 0x3f, 0x84, 0xa5, 0xe2, 0x70, 0x46, 0x70, 0x38, 0x0d, 0x67, 0x07, 0x71, 0x10, 0xbd,
-	0x94, 0x27, 0x40, 0xb5, 0xbe, 0x03, 0x6e, 0x7b, 0xb4, 0x63, 0x1e, 0x70, 0xdb, 0x63, 0x1d, 0x34,
-	0x29, 0x01, 0x61, 0x57, 0x85, 0x5f, 0x6b, 0xe0, 0x58, 0x5f, 0x1b, 0x84, 0x49, 0xef, 0x5c, 0x52,
-	0x47, 0x2d, 0xac, 0x1e, 0xde, 0x41, 0x11, 0x3b, 0x2b, 0x88, 0xcd, 0xc3, 0xd3, 0x71, 0x62, 0xb1,
-	0xae, 0xcb, 0xef, 0x9f, 0x52, 0x64, 0xe7, 0x12, 0x6f, 0x75, 0xa4, 0xbb, 0x26, 0xde, 0xbf, 0x78,
-	0xb7, 0x4d, 0xba, 0x7f, 0xb2, 0xa9, 0x6e, 0x5e, 0x79, 0xf6, 0xb2, 0xa8, 0x3d, 0x7f, 0x59, 0xd4,
-	0x7e, 0x79, 0x59, 0xd4, 0x1e, 0xbf, 0x2a, 0x8e, 0x3c, 0x7f, 0x55, 0x1c, 0xf9, 0xf1, 0x55, 0x71,
-	0xe4, 0xc3, 0xa5, 0x88, 0xd8, 0xea, 0x78, 0x12, 0x6a, 0xb6, 0xd6, 0x57, 0xcd, 0xb6, 0x88, 0x22,
-	0x04, 0x57, 0x65, 0x5c, 0x08, 0xbc, 0x7f, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x02, 0xd3, 0xdf,
-	0xf6, 0x1a, 0x14, 0x00, 0x00,
+	// 1788 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x9a, 0xb4, 0x48, 0x3d, 0x4a, 0xb1, 0x3c, 0xa2, 0x1d, 0x6a, 0x2d, 0x89, 0xf2, 0x3a,
+	0xa2, 0x14, 0x37, 0xde, 0x95, 0xd4, 0x36, 0x40, 0xdb, 0x43, 0x63, 0x0a, 0x8e, 0xe2, 0xc6, 0x0e,
+	0xdc, 0x8d, 0xd0, 0x43, 0x81, 0x82, 0x18, 0x2e, 0xc7, 0xcb, 0x85, 0xb8, 0x3b, 0x9b, 0x9d, 0x25,
+	0x41, 0x27, 0xf0, 0xa1, 0x41, 0xd1, 0x0f, 0xf4, 0x12, 0x20, 0xb7, 0xf6, 0xe2, 0x63, 0x81, 0x02,
+	0x45, 0x6f, 0xf9, 0x17, 0x72, 0x0c, 0xd0, 0x4b, 0xd1, 0x83, 0x5b, 0xd8, 0x05, 0xda, 0x7f, 0xa0,
+	0x97, 0x9e, 0x8a, 0xf9, 0x58, 0x72, 0x57, 0xe4, 0x92, 0x4c, 0xe1, 0xde, 0x7a, 0x21, 0x77, 0x66,
+	0xde, 0xc7, 0xef, 0xbd, 0x79, 0x6f, 0xe6, 0x37, 0xb0, 0x45, 0xe2, 0x2e, 0x89, 0x7c, 0x2f, 0x88,
+	0x2d, 0x32, 0xf0, 0xad, 0xc1, 0x91, 0xf5, 0x51, 0x9f, 0x44, 0x4f, 0xcc, 0x30, 0xa2, 0x31, 0x45,
+	0xeb, 0xa3, 0x55, 0x93, 0x0c, 0x7c, 0x73, 0x70, 0xa4, 0x5f, 0xc5, 0xbe, 0x17, 0x50, 0x4b, 0xfc,
+	0x4a, 0x21, 0xfd, 0xb6, 0x43, 0x99, 0x4f, 0x99, 0xd5, 0xc6, 0x8c, 0x48, 0x6d, 0x6b, 0x70, 0xd4,
+	0x26, 0x31, 0x3e, 0xb2, 0x42, 0xec, 0x7a, 0x01, 0x8e, 0x3d, 0x1a, 0x28, 0x59, 0x7d, 0xc2, 0x1d,
+	0xb7, 0x2b, 0xd7, 0x36, 0x27, 0xd6, 0xe2, 0xa1, 0x5a, 0xaa, 0xba, 0xd4, 0xa5, 0xe2, 0xd3, 0xe2,
+	0x5f, 0x6a, 0x76, 0xcb, 0xa5, 0xd4, 0xed, 0x11, 0x0b, 0x87, 0x9e, 0x85, 0x83, 0x80, 0xc6, 0xc2,
+	0x13, 0x53, 0xab, 0x75, 0xb5, 0x2a, 0x46, 0xed, 0xfe, 0x63, 0x2b, 0xf6, 0x7c, 0xc2, 0x62, 0xec,
+	0x87, 0x52, 0xc0, 0xf8, 0x0e, 0x6c, 0xfc, 0x90, 0xa3, 0xbd, 0xeb, 0x38, 0xb4, 0x1f, 0xc4, 0x36,
+	0xf9, 0xa8, 0x4f, 0x58, 0x8c, 0x6a, 0x50, 0xc2, 0x9d, 0x4e, 0x44, 0x18, 0xab, 0x69, 0xbb, 0xda,
+	0xc1, 0x8a, 0x9d, 0x0c, 0xbf, 0x5b, 0xfe, 0xe5, 0xb3, 0xfa, 0xd2, 0x3f, 0x9f, 0xd5, 0x97, 0x0c,
+	0x07, 0xaa, 0x59, 0x55, 0x16, 0xd2, 0x80, 0x11, 0xae, 0xdb, 0xc6, 0x3d, 0x1c, 0x38, 0x24, 0xd1,
+	0x55, 0x43, 0x74, 0x03, 0x56, 0x1c, 0xda, 0x21, 0xad, 0x2e, 0x66, 0xdd, 0xda, 0x25, 0xb1, 0x56,
+	0xe6, 0x13, 0xef, 0x61, 0xd6, 0x45, 0x55, 0xb8, 0x1c, 0x50, 0xae, 0x54, 0xd8, 0xd5, 0x0e, 0x8a,
+	0xb6, 0x1c, 0x18, 0xdf, 0x87, 0x4d, 0xe1, 0xe4, 0x44, 0xa4, 0xf7, 0xbf, 0x40, 0xf9, 0x73, 0x0d,
+	0xf4, 0x69, 0x16, 0x14, 0xd8, 0x3d, 0x78, 0x4d, 0xee, 0x5c, 0x2b, 0x6b, 0x69, 0x4d, 0xce, 0xde,
+	0x95, 0x93, 0x48, 0x87, 0x32, 0xe3, 0x4e, 0x39, 0xbe, 0x4b, 0x02, 0xdf, 0x68, 0xcc, 0x4d, 0x60,
+	0x69, 0xb5, 0x15, 0xf4, 0xfd, 0x36, 0x89, 0x54, 0x04, 0x6b, 0x6a, 0xf6, 0x03, 0x31, 0x69, 0xbc,
+	0x0f, 0x5b, 0x02, 0xc7, 0x8f, 0x70, 0xcf, 0xeb, 0xe0, 0x98, 0x46, 0x17, 0x82, 0xb9, 0x09, 0xab,
+	0x0e, 0x0d, 0x2e, 0xe2, 0xa8, 0xf0, 0xb9, 0xbb, 0x13, 0x51, 0xfd, 0x5a, 0x83, 0xed, 0x1c, 0x6b,
+	0x2a, 0xb0, 0x7d, 0xb8, 0x92, 0xa0, 0xca, 0x5a, 0x4c, 0xc0, 0xbe, 0xc2, 0xd0, 0x92, 0x22, 0x6a,
+	0xca, 0x7d, 0xfe, 0x3a, 0xdb, 0x73, 0x08, 0xd5, 0xac, 0xea, 0xbc, 0x22, 0x32, 0xde, 0x57, 0xce,
+	0x3e, 0x8c, 0x69, 0x84, 0xdd, 0xf9, 0xce, 0xd0, 0x3a, 0x14, 0xce, 0xc9, 0x13, 0x55, 0x6f, 0xfc,
+	0x33, 0xe5, 0xfe, 0x2d, 0xa8, 0x66, 0x8d, 0x29, 0xf7, 0x55, 0xb8, 0x3c, 0xc0, 0xbd, 0x7e, 0xe2,
+	0x5c, 0x0e, 0x8c, 0xb7, 0x61, 0x5d, 0x95, 0x52, 0xe7, 0x6b, 0x05, 0xb9, 0x0f, 0x57, 0x53, 0x7a,
+	0xca, 0x05, 0x82, 0x22, 0xaf, 0x7d, 0xa1, 0xb5, 0x6a, 0x8b, 0x6f, 0xe3, 0x63, 0x40, 0x42, 0xf0,
+	0x6c, 0xf8, 0x80, 0xba, 0x2c, 0x71, 0x81, 0xa0, 0x28, 0x3a, 0x46, 0xda, 0x17, 0xdf, 0xe8, 0x5d,
+	0x80, 0xf1, 0xb9, 0x22, 0x62, 0xab, 0x1c, 0x37, 0x4c, 0x59, 0xb4, 0x26, 0x3f, 0x84, 0x4c, 0x79,
+	0x84, 0xa9, 0x43, 0xc8, 0x7c, 0x34, 0x4e, 0x95, 0x9d, 0xd2, 0x4c, 0x81, 0xfc, 0x95, 0x06, 0x1b,
+	0x19, 0xe7, 0x0a, 0xe7, 0x9b, 0x50, 0xec, 0x51, 0x97, 0x47, 0x57, 0x38, 0xa8, 0x1c, 0x5f, 0x33,
+	0x2f, 0x9e, 0x86, 0xe6, 0x03, 0xea, 0xda, 0x42, 0x04, 0x9d, 0x4e, 0x01, 0xb5, 0x3f, 0x17, 0x94,
+	0xf4, 0x93, 0x46, 0x65, 0x54, 0x55, 0x1e, 0x1e, 0xe1, 0x08, 0xfb, 0x49, 0x1e, 0x0c, 0x1b, 0x36,
+	0x32, 0xb3, 0x0a, 0xe0, 0xf7, 0x60, 0x39, 0x14, 0x33, 0x22, 0x41, 0x95, 0xe3, 0xda, 0x24, 0x44,
+	0xa9, 0xd1, 0x5c, 0xf9, 0xf2, 0x79, 0x7d, 0xe9, 0x77, 0xff, 0xf8, 0xe3, 0x6d, 0xcd, 0x56, 0x2a,
+	0xc6, 0x17, 0x1a, 0xbc, 0x76, 0x2f, 0xee, 0x9e, 0xe0, 0x5e, 0x2f, 0x95, 0x6e, 0x1c, 0xb9, 0x2c,
+	0xd9, 0x18, 0xfe, 0x8d, 0x5e, 0x87, 0x92, 0x8b, 0x59, 0xcb, 0xc1, 0xa1, 0xea, 0x91, 0x65, 0x17,
+	0xb3, 0x13, 0x1c, 0xa2, 0x9f, 0xc0, 0x7a, 0x18, 0xd1, 0x90, 0x32, 0x12, 0x8d, 0xfa, 0x8c, 0xf7,
+	0xc8, 0x6a, 0xf3, 0xf8, 0xdf, 0xcf, 0xeb, 0xa6, 0xeb, 0xc5, 0xdd, 0x7e, 0xdb, 0x74, 0xa8, 0x6f,
+	0xa9, 0x0b, 0x42, 0xfe, 0xdd, 0x61, 0x9d, 0x73, 0x2b, 0x7e, 0x12, 0x12, 0x66, 0x9e, 0x8c, 0x1b,
+	0xdc, 0xbe, 0x92, 0xd8, 0x4a, 0x9a, 0x73, 0x13, 0xca, 0x4e, 0x17, 0x7b, 0x41, 0xcb, 0xeb, 0xd4,
+	0x8a, 0xbb, 0xda, 0x41, 0xc1, 0x2e, 0x89, 0xf1, 0xfd, 0x8e, 0x71, 0x06, 0x1b, 0xf7, 0x58, 0xec,
+	0xf9, 0x38, 0x26, 0xa7, 0x78, 0x9c, 0x8d, 0x75, 0x28, 0xb8, 0x58, 0x82, 0x2f, 0xda, 0xfc, 0x93,
+	0xcf, 0x44, 0x24, 0x16, 0xb8, 0x57, 0x6d, 0xfe, 0xc9, 0xad, 0x0e, 0xfc, 0x16, 0x89, 0x22, 0x2a,
+	0x1b, 0x7a, 0xc5, 0x2e, 0x0d, 0xfc, 0x7b, 0x7c, 0x68, 0xfc, 0xa1, 0x98, 0x54, 0x41, 0x84, 0x1d,
+	0x72, 0x36, 0x4c, 0x92, 0x72, 0x04, 0x05, 0x9f, 0xb9, 0x2a, 0xc3, 0xf5, 0xc9, 0x0c, 0x3f, 0x64,
+	0xee, 0x3d, 0x3e, 0x47, 0xfa, 0xfe, 0xd9, 0xd0, 0xe6, 0xb2, 0xe8, 0x1d, 0x58, 0x8d, 0xb9, 0x91,
+	0x96, 0x43, 0x83, 0xc7, 0x9e, 0x2b, 0x3c, 0x55, 0x8e, 0xb7, 0x27, 0x75, 0x85, 0xab, 0x13, 0x21,
+	0x64, 0x57, 0xe2, 0xf1, 0x00, 0x9d, 0xc0, 0x6a, 0x18, 0x91, 0x0e, 0x71, 0x08, 0x63, 0x34, 0x62,
+	0xb5, 0xe2, 0x6e, 0x61, 0x11, 0xef, 0x19, 0x25, 0x7e, 0xae, 0xb6, 0x7b, 0xd4, 0x39, 0x4f, 0x4e,
+	0xb0, 0xcb, 0x22, 0x8d, 0x15, 0x31, 0x27, 0xcf, 0x2f, 0xb4, 0x0d, 0x20, 0x45, 0x44, 0x9b, 0x2d,
+	0x8b, 0x8c, 0xac, 0x88, 0x19, 0x71, 0x33, 0xbd, 0x97, 0x2c, 0xf3, 0xcb, 0xb3, 0x56, 0x12, 0x61,
+	0xe8, 0xa6, 0xbc, 0x59, 0xcd, 0xe4, 0x66, 0x35, 0xcf, 0x92, 0x9b, 0xb5, 0xb9, 0xc6, 0xcb, 0xec,
+	0xb3, 0xbf, 0xd6, 0x35, 0x59, 0x6a, 0xd2, 0x12, 0x5f, 0x9e, 0x5a, 0x2d, 0xe5, 0xff, 0x4d, 0xb5,
+	0xac, 0x64, 0xaa, 0x05, 0x19, 0xb0, 0x26, 0x63, 0xf0, 0xf1, 0xb0, 0xc5, 0x0b, 0x04, 0x52, 0x69,
+	0x78, 0x88, 0x87, 0xa7, 0x98, 0xa1, 0x5b, 0xb0, 0xd6, 0x0f, 0x98, 0xe7, 0x06, 0xa4, 0xd3, 0x72,
+	0x70, 0xaf, 0x57, 0xab, 0xec, 0x6a, 0x07, 0x65, 0x7b, 0x35, 0x99, 0xe4, 0x4d, 0xf2, 0x83, 0x62,
+	0xf9, 0xd2, 0x7a, 0xc1, 0x2e, 0xc7, 0xc3, 0x96, 0x17, 0x74, 0xc8, 0xd0, 0xb8, 0xad, 0x4e, 0xd0,
+	0x51, 0xbd, 0x8c, 0x8f, 0xb7, 0x0e, 0x8e, 0x71, 0xd2, 0x45, 0xfc, 0xdb, 0xf8, 0xa2, 0x00, 0xd7,
+	0xc7, 0xc2, 0x4d, 0xee, 0x3a, 0x55, 0x5f, 0xf1, 0x30, 0x39, 0x64, 0xe6, 0xd7, 0x57, 0x3c, 0x64,
+	0xaf, 0xa0, 0xbe, 0xfe, 0x5f, 0x1a, 0x0b, 0x96, 0x86, 0x71, 0x07, 0x5e, 0x9f, 0xd8, 0xb8, 0x19,
+	0x1b, 0x7d, 0x6d, 0x44, 0x08, 0x18, 0x79, 0x97, 0x24, 0x17, 0x8f, 0xf1, 0x00, 0xaa, 0xd9, 0x69,
+	0x65, 0xe2, 0x5b, 0x50, 0xe6, 0xb7, 0x43, 0xeb, 0x31, 0x51, 0x17, 0x6e, 0x73, 0xf3, 0x2f, 0xcf,
+	0xeb, 0xd7, 0x64, 0x84, 0xac, 0x73, 0x6e, 0x7a, 0xd4, 0xf2, 0x71, 0xdc, 0x35, 0xef, 0x07, 0x31,
+	0x27, 0x02, 0x42, 0xdb, 0xa8, 0x2b, 0x0a, 0x74, 0xda, 0xa3, 0x6d, 0xdc, 0x7b, 0xe8, 0x05, 0xa7,
+	0x98, 0x3d, 0x8a, 0xbc, 0x11, 0xff, 0x30, 0x1c, 0xd8, 0xc9, 0x13, 0x50, 0x8e, 0xef, 0xc2, 0x9a,
+	0xef, 0x05, 0x3c, 0xe8, 0x56, 0xc8, 0x17, 0x94, 0xf7, 0x6d, 0xbe, 0x4b, 0xf9, 0x08, 0x2a, 0xfe,
+	0xd8, 0xd4, 0xe8, 0xaa, 0x52, 0xf5, 0x35, 0x8a, 0x74, 0x23, 0x33, 0xab, 0xfc, 0x7d, 0x1b, 0x96,
+	0x55, 0xb1, 0x6a, 0x79, 0xc5, 0x7a, 0xc2, 0x77, 0x45, 0xa9, 0x29, 0xe1, 0x51, 0xa4, 0x1f, 0x72,
+	0x72, 0xef, 0x3c, 0x8a, 0x88, 0x43, 0xfd, 0xd0, 0xeb, 0x91, 0xd1, 0xcd, 0xf8, 0xb9, 0x06, 0xd5,
+	0x8b, 0x8b, 0xf7, 0x83, 0xc7, 0x74, 0x06, 0x2b, 0x42, 0x50, 0x0c, 0xb0, 0x4f, 0x14, 0x2d, 0x12,
+	0xdf, 0x5c, 0x7a, 0x40, 0x22, 0xc6, 0x2f, 0x6f, 0xc9, 0xf3, 0x92, 0x21, 0x2f, 0x1f, 0xdc, 0xf6,
+	0x64, 0x13, 0x14, 0x95, 0xa1, 0xb6, 0x27, 0x5a, 0xa0, 0x06, 0x25, 0x12, 0xe0, 0x76, 0x8f, 0x74,
+	0x44, 0xff, 0x94, 0xed, 0x64, 0x68, 0x84, 0x2a, 0xff, 0x53, 0x60, 0xab, 0x7c, 0x7c, 0x00, 0x95,
+	0x70, 0x3c, 0xad, 0xba, 0xbf, 0x31, 0x99, 0x94, 0x69, 0xb1, 0x35, 0x8b, 0x7c, 0x97, 0xec, 0xb4,
+	0x81, 0xe3, 0x7f, 0x5d, 0x81, 0xcb, 0xc2, 0x25, 0xfa, 0xa9, 0x06, 0x25, 0x45, 0x89, 0xd1, 0xde,
+	0xa4, 0xc1, 0x29, 0x6f, 0x1e, 0xbd, 0x31, 0x4f, 0x4c, 0x82, 0x36, 0xf6, 0x3f, 0xfd, 0xd3, 0xdf,
+	0x3f, 0xbf, 0x74, 0x13, 0xd5, 0xf9, 0x0b, 0x8d, 0xb2, 0xe4, 0x9d, 0xa6, 0x28, 0xb1, 0xf5, 0x89,
+	0xca, 0xf0, 0x53, 0xf4, 0x1b, 0x0d, 0xd6, 0x32, 0xaf, 0x0e, 0xf4, 0x8d, 0x1c, 0x17, 0xd3, 0x5e,
+	0x37, 0xfa, 0x5b, 0x8b, 0x09, 0x2b, 0x54, 0xa6, 0x40, 0x75, 0x80, 0x1a, 0x59, 0x54, 0xc9, 0xe3,
+	0x66, 0x02, 0xdc, 0xef, 0x35, 0x58, 0xbf, 0xf8, 0x78, 0x40, 0x66, 0x8e, 0xcb, 0x9c, 0x37, 0x8b,
+	0x6e, 0x2d, 0x2c, 0xaf, 0x50, 0xbe, 0x2d, 0x50, 0x1e, 0x22, 0x33, 0x8b, 0x72, 0x90, 0xc8, 0x8f,
+	0x81, 0xa6, 0xdf, 0x42, 0x4f, 0xd1, 0xa7, 0x1a, 0x94, 0xd4, 0x13, 0x21, 0x77, 0x3b, 0xb3, 0xaf,
+	0x0f, 0xbd, 0x31, 0x4f, 0x4c, 0x41, 0x3a, 0x10, 0x90, 0x0c, 0xb4, 0x9b, 0x85, 0xa4, 0x9e, 0x1b,
+	0x2c, 0x95, 0xb2, 0x5f, 0x68, 0x50, 0x52, 0x0f, 0x85, 0x5c, 0x10, 0xd9, 0x57, 0x89, 0xde, 0x98,
+	0x27, 0xa6, 0x40, 0xdc, 0x11, 0x20, 0xf6, 0xd1, 0x5e, 0x16, 0x04, 0x93, 0x62, 0x63, 0x0c, 0xd6,
+	0x27, 0xe7, 0xe4, 0xc9, 0x53, 0x34, 0x80, 0x22, 0x7f, 0x4b, 0x20, 0x23, 0xb7, 0x44, 0x46, 0x0f,
+	0x14, 0xfd, 0xd6, 0x4c, 0x19, 0xe5, 0x7f, 0x4f, 0xf8, 0xaf, 0xa3, 0xed, 0x8b, 0xd5, 0xd3, 0xc9,
+	0x64, 0x80, 0xc1, 0xb2, 0xa4, 0xd2, 0xe8, 0x8d, 0x1c, 0xab, 0x19, 0xc6, 0xae, 0xef, 0xcd, 0x91,
+	0x52, 0xde, 0xb7, 0x84, 0xf7, 0xeb, 0xa8, 0x9a, 0xf5, 0x2e, 0x29, 0x3a, 0x8a, 0xa1, 0xa4, 0x18,
+	0x3a, 0xda, 0x9d, 0xb4, 0x97, 0x25, 0xef, 0xfa, 0xfe, 0x3c, 0xea, 0x90, 0xf8, 0xdc, 0x11, 0x3e,
+	0x6b, 0xe8, 0x7a, 0xd6, 0x27, 0x89, 0xbb, 0x82, 0xfb, 0xa0, 0x8f, 0xa1, 0x92, 0xa2, 0xd7, 0x0b,
+	0x78, 0x9e, 0x12, 0xeb, 0x14, 0x7e, 0x6e, 0x18, 0xc2, 0xef, 0x16, 0xd2, 0x2f, 0xf8, 0x55, 0xa2,
+	0xfc, 0x2e, 0x42, 0x43, 0x28, 0x29, 0x3a, 0x95, 0x5b, 0x67, 0x59, 0x7a, 0xae, 0x37, 0xe6, 0x89,
+	0xcd, 0x8e, 0x5a, 0xf2, 0xa8, 0x78, 0x88, 0x7e, 0xa6, 0x01, 0x8c, 0xef, 0x78, 0x74, 0x30, 0xcb,
+	0x6c, 0x9a, 0xbf, 0xe9, 0x6f, 0x2e, 0x20, 0xa9, 0x30, 0xdc, 0x14, 0x18, 0x6e, 0xa0, 0xcd, 0x69,
+	0x18, 0x04, 0xe9, 0xe0, 0x09, 0x50, 0x1c, 0x61, 0x46, 0xb7, 0xa7, 0xa9, 0x85, 0xde, 0x98, 0x27,
+	0x36, 0x3b, 0x01, 0x09, 0xfd, 0x40, 0xbf, 0xd5, 0xe0, 0xea, 0x04, 0x5f, 0x40, 0x79, 0xe7, 0x5c,
+	0x1e, 0xf5, 0xd0, 0x0f, 0x17, 0x57, 0x50, 0xc0, 0x6e, 0x09, 0x60, 0xdb, 0xe8, 0x46, 0x16, 0x58,
+	0x86, 0x9e, 0xf0, 0xfe, 0x53, 0xd4, 0xf5, 0x8d, 0xdc, 0xae, 0x4e, 0xd1, 0x10, 0x7d, 0x6f, 0x8e,
+	0xd4, 0xec, 0xfe, 0x93, 0xec, 0x03, 0x3d, 0xd3, 0xe0, 0xea, 0xc4, 0x15, 0x9e, 0x9b, 0x92, 0x3c,
+	0x8e, 0xa2, 0x1f, 0x2e, 0xae, 0x30, 0xfb, 0x64, 0x66, 0x42, 0xa1, 0x95, 0xba, 0xf7, 0x9b, 0xef,
+	0x7c, 0xf9, 0x62, 0x47, 0xfb, 0xea, 0xc5, 0x8e, 0xf6, 0xb7, 0x17, 0x3b, 0xda, 0x67, 0x2f, 0x77,
+	0x96, 0xbe, 0x7a, 0xb9, 0xb3, 0xf4, 0xe7, 0x97, 0x3b, 0x4b, 0x3f, 0x6e, 0xa4, 0x88, 0xf3, 0xc8,
+	0x0a, 0x65, 0xd6, 0xe0, 0xf8, 0xd0, 0x1a, 0x0a, 0x8b, 0x82, 0x3c, 0xb7, 0x97, 0x05, 0x59, 0xff,
+	0xe6, 0x7f, 0x06, 0x00, 0xc7, 0xd1, 0x34, 0x4d, 0x0b, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1668,6 +1856,8 @@ type QueryClient interface {
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	// StaticPrecompiles queries the information of the available static precompiles
+	StaticPrecompiles(ctx context.Context, in *QueryStaticPrecompilesRequest, opts ...grpc.CallOption) (*QueryStaticPrecompilesResponse, error)
 }
 
 type queryClient struct {
@@ -1804,6 +1994,15 @@ func (c *queryClient) Config(ctx context.Context, in *QueryConfigRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) StaticPrecompiles(ctx context.Context, in *QueryStaticPrecompilesRequest, opts ...grpc.CallOption) (*QueryStaticPrecompilesResponse, error) {
+	out := new(QueryStaticPrecompilesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/StaticPrecompiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1840,6 +2039,8 @@ type QueryServer interface {
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
+	// StaticPrecompiles queries the information of the available static precompiles
+	StaticPrecompiles(context.Context, *QueryStaticPrecompilesRequest) (*QueryStaticPrecompilesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1888,6 +2089,9 @@ func (*UnimplementedQueryServer) GlobalMinGasPrice(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigRequest) (*QueryConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
+func (*UnimplementedQueryServer) StaticPrecompiles(ctx context.Context, req *QueryStaticPrecompilesRequest) (*QueryStaticPrecompilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StaticPrecompiles not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2145,6 +2349,25 @@ func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StaticPrecompiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStaticPrecompilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StaticPrecompiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/StaticPrecompiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StaticPrecompiles(ctx, req.(*QueryStaticPrecompilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2205,6 +2428,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Config",
 			Handler:    _Query_Config_Handler,
 		},
+		{
+			MethodName: "StaticPrecompiles",
+			Handler:    _Query_StaticPrecompiles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/query.proto",
@@ -2865,6 +3092,16 @@ func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnsignedCall {
+		i--
+		if m.UnsignedCall {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.BlockMaxGas != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
 		i--
@@ -3261,6 +3498,125 @@ func (m *QueryConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryStaticPrecompilesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaticPrecompilesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaticPrecompilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StaticPrecompileInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StaticPrecompileInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StaticPrecompileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AbiHash) > 0 {
+		i -= len(m.AbiHash)
+		copy(dAtA[i:], m.AbiHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AbiHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaticPrecompilesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaticPrecompilesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaticPrecompilesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Precompiles) > 0 {
+		for iNdEx := len(m.Precompiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Precompiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3589,6 +3945,9 @@ func (m *QueryTraceTxRequest) Size() (n int) {
 	if m.BlockMaxGas != 0 {
 		n += 1 + sovQuery(uint64(m.BlockMaxGas))
 	}
+	if m.UnsignedCall {
+		n += 2
+	}
 	return n
 }
 
@@ -3720,6 +4079,57 @@ func (m *QueryConfigResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStaticPrecompilesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StaticPrecompileInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	l = len(m.AbiHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryStaticPrecompilesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Precompiles) > 0 {
+		for _, e := range m.Precompiles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5824,10 +6234,30 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsignedCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnsignedCall = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -6695,6 +7125,325 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryStaticPrecompilesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaticPrecompilesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaticPrecompilesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StaticPrecompileInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StaticPrecompileInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StaticPrecompileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbiHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbiHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaticPrecompilesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaticPrecompilesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaticPrecompilesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Precompiles = append(m.Precompiles, StaticPrecompileInfo{})
+			if err := m.Precompiles[len(m.Precompiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/utils/dust"
 )
 
 // MustConvertEvmCoinTo18Decimals converts the coin's Amount from its original
@@ -59,34 +61,65 @@ func ConvertBigIntFrom18DecimalsToLegacyDec(amt *big.Int) sdkmath.LegacyDec {
 
 // ConvertEvmCoinFrom18Decimals converts the coin's Amount from 18 decimals to its
 // original representation. Return an error if the coin denom is not the EVM.
+// The amount is truncated following the dust policy, use
+// ConvertEvmCoinFrom18DecimalsWithDust to retrieve the remainder.
 func ConvertEvmCoinFrom18Decimals(coin sdk.Coin) (sdk.Coin, error) {
+	convertedCoin, _, err := ConvertEvmCoinFrom18DecimalsWithDust(coin)
+	return convertedCoin, err
+}
+
+// ConvertEvmCoinFrom18DecimalsWithDust converts the coin's Amount from 18
+// decimals to its original representation. It additionally returns the
+// truncated sub-unit remainder, expressed in the original representation.
+func ConvertEvmCoinFrom18DecimalsWithDust(coin sdk.Coin) (sdk.Coin, sdk.DecCoin, error) {
 	if coin.Denom != GetEVMCoinDenom() {
-		return sdk.Coin{}, fmt.Errorf("expected coin denom %s, received %s", GetEVMCoinDenom(), coin.Denom)
+		return sdk.Coin{}, sdk.DecCoin{}, fmt.Errorf("expected coin denom %s, received %s", GetEVMCoinDenom(), coin.Denom)
 	}
 
-	evmCoinDecimal := GetEVMCoinDecimals()
-	newAmount := coin.Amount.Quo(evmCoinDecimal.ConversionFactor())
+	newAmount, remainder := convertAmountFrom18Decimals(coin.Amount)
 
-	return sdk.Coin{Denom: coin.Denom, Amount: newAmount}, nil
+	return sdk.Coin{Denom: coin.Denom, Amount: newAmount}, sdk.NewDecCoinFromDec(coin.Denom, remainder), nil
 }
 
 // ConvertCoinsFrom18Decimals returns the given coins with the Amount of the evm
-// coin converted from the 18 decimals representation to the original one.
+// coin converted from the 18 decimals representation to the original one. The
+// amount is truncated following the dust policy, use
+// ConvertCoinsFrom18DecimalsWithDust to retrieve the remainder.
 func ConvertCoinsFrom18Decimals(coins sdk.Coins) sdk.Coins {
+	convertedCoins, _ := ConvertCoinsFrom18DecimalsWithDust(coins)
+	return convertedCoins
+}
+
+// ConvertCoinsFrom18DecimalsWithDust returns the given coins with the Amount of
+// the evm coin converted from the 18 decimals representation to the original
+// one, together with the truncated sub-unit remainder.
+func ConvertCoinsFrom18DecimalsWithDust(coins sdk.Coins) (sdk.Coins, sdk.DecCoins) {
 	evmDenom := GetEVMCoinDenom()
 
+	var remainders sdk.DecCoins
 	convertedCoins := make(sdk.Coins, len(coins))
 	for i, coin := range coins {
 		if coin.Denom == evmDenom {
-			evmCoinDecimals := GetEVMCoinDecimals()
-
-			newAmount := coin.Amount.Quo(evmCoinDecimals.ConversionFactor())
+			newAmount, remainder := convertAmountFrom18Decimals(coin.Amount)
+			if remainder.IsPositive() {
+				remainders = remainders.Add(sdk.NewDecCoinFromDec(coin.Denom, remainder))
+			}
 
 			coin = sdk.Coin{Denom: coin.Denom, Amount: newAmount}
 		}
 		convertedCoins[i] = coin
 	}
-	return convertedCoins
+	return convertedCoins, remainders
+}
+
+// convertAmountFrom18Decimals scales the amount down from 18 decimals to the
+// evm coin decimals. It returns the truncated amount and the sub-unit
+// remainder in the evm coin decimals.
+func convertAmountFrom18Decimals(amt sdkmath.Int) (sdkmath.Int, sdkmath.LegacyDec) {
+	conversionFactor := GetEVMCoinDecimals().ConversionFactor()
+
+	newAmount, remainder := dust.Quo(amt, conversionFactor)
+	return newAmount, sdkmath.LegacyNewDecFromInt(remainder).QuoInt(conversionFactor)
 }
 
 // AdjustExtraDecimalsBigInt replaces all extra decimals by zero of an amount with 18 decimals in big.Int when having a decimal configuration different than 18 decimals
@@ -212,6 +212,86 @@ func TestConvertCoinsFrom18Decimals(t *testing.T) {
 	}
 }
 
+func TestConvertEvmCoinFrom18DecimalsWithDust(t *testing.T) {
+	testCases := []struct {
+		name        string
+		evmCoinInfo evmtypes.EvmCoinInfo
+		amt         math.Int
+		expAmt      math.Int
+		expDust     math.LegacyDec
+	}{
+		{
+			name:        "pass - no dust with 18 decimals",
+			evmCoinInfo: evmtypes.EvmCoinInfo{Denom: types.BaseDenom, Decimals: evmtypes.EighteenDecimals},
+			amt:         math.NewInt(1e11 + 1),
+			expAmt:      math.NewInt(1e11 + 1),
+			expDust:     math.LegacyZeroDec(),
+		},
+		{
+			name:        "pass - no dust with 6 decimals",
+			evmCoinInfo: evmtypes.EvmCoinInfo{Denom: types.BaseDenom, Decimals: evmtypes.SixDecimals},
+			amt:         math.NewInt(2e12),
+			expAmt:      math.NewInt(2),
+			expDust:     math.LegacyZeroDec(),
+		},
+		{
+			name:        "pass - dust with 6 decimals",
+			evmCoinInfo: evmtypes.EvmCoinInfo{Denom: types.BaseDenom, Decimals: evmtypes.SixDecimals},
+			amt:         math.NewInt(2e12 + 5e11),
+			expAmt:      math.NewInt(2),
+			expDust:     math.LegacyMustNewDecFromStr("0.5"),
+		},
+		{
+			name:        "pass - amount less than conversion factor is all dust",
+			evmCoinInfo: evmtypes.EvmCoinInfo{Denom: types.BaseDenom, Decimals: evmtypes.SixDecimals},
+			amt:         math.NewInt(1),
+			expAmt:      math.ZeroInt(),
+			expDust:     math.LegacyMustNewDecFromStr("0.000000000001"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configurator := evmtypes.NewEVMConfigurator()
+			configurator.ResetTestConfig()
+			require.NoError(t, configurator.WithEVMCoinInfo(tc.evmCoinInfo.Denom, uint8(tc.evmCoinInfo.Decimals)).Configure())
+
+			coin, remainder, err := evmtypes.ConvertEvmCoinFrom18DecimalsWithDust(sdk.Coin{Denom: types.BaseDenom, Amount: tc.amt})
+			require.NoError(t, err)
+			require.Equal(t, tc.expAmt.String(), coin.Amount.String())
+			require.Equal(t, types.BaseDenom, remainder.Denom)
+			require.Equal(t, tc.expDust.String(), remainder.Amount.String())
+
+			// the converted amount and the dust add up to the original amount
+			total := math.LegacyNewDecFromInt(coin.Amount).Add(remainder.Amount)
+			require.Equal(t, math.LegacyNewDecFromInt(tc.amt).String(), evmtypes.ConvertAmountTo18DecimalsLegacy(total).String())
+		})
+	}
+
+	_, _, err := evmtypes.ConvertEvmCoinFrom18DecimalsWithDust(sdk.Coin{Denom: "evmos", Amount: math.NewInt(1)})
+	require.Error(t, err)
+}
+
+func TestConvertCoinsFrom18DecimalsWithDust(t *testing.T) {
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, configurator.WithEVMCoinInfo(types.BaseDenom, uint8(evmtypes.SixDecimals)).Configure())
+
+	nonBaseCoin := sdk.Coin{Denom: "btc", Amount: math.NewInt(10)}
+
+	// only the evm coin produces dust
+	coins, remainder := evmtypes.ConvertCoinsFrom18DecimalsWithDust(sdk.Coins{
+		nonBaseCoin,
+		sdk.Coin{Denom: types.BaseDenom, Amount: math.NewInt(3e12 + 25e10)},
+	})
+	require.Equal(t, sdk.Coins{nonBaseCoin, sdk.Coin{Denom: types.BaseDenom, Amount: math.NewInt(3)}}.String(), coins.String())
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.BaseDenom, math.LegacyMustNewDecFromStr("0.25"))), remainder)
+
+	// no dust when the amount is a multiple of the conversion factor
+	_, remainder = evmtypes.ConvertCoinsFrom18DecimalsWithDust(sdk.Coins{sdk.Coin{Denom: types.BaseDenom, Amount: math.NewInt(3e12)}})
+	require.True(t, remainder.IsZero())
+}
+
 func TestZeroExtraDecimalsBigInt(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -9,6 +9,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v20/x/evm/core/logger"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
@@ -22,8 +24,9 @@ const (
 )
 
 // NewTracer creates a new Logger tracer to collect execution traces from an
-// EVM transaction.
-func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height int64) vm.EVMLogger {
+// EVM transaction. The given static precompiles are excluded from the access
+// list together with the default Ethereum precompiles.
+func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height int64, staticPrecompiles []common.Address) vm.EVMLogger {
 	// TODO: enable additional log configuration
 	logCfg := &logger.Config{
 		Debug: true,
@@ -31,8 +34,17 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 
 	switch tracer {
 	case TracerAccessList:
-		preCompiles := vm.DefaultActivePrecompiles(cfg.Rules(big.NewInt(height), cfg.MergeNetsplitBlock != nil))
-		return logger.NewAccessListTracer(msg.AccessList(), msg.From(), *msg.To(), preCompiles)
+		defaultPrecompiles := vm.DefaultActivePrecompiles(cfg.Rules(big.NewInt(height), cfg.MergeNetsplitBlock != nil))
+		// copy the default precompiles so that the shared slice is never modified
+		preCompiles := make([]common.Address, 0, len(defaultPrecompiles)+len(staticPrecompiles))
+		preCompiles = append(preCompiles, defaultPrecompiles...)
+		preCompiles = append(preCompiles, staticPrecompiles...)
+		// contract creations have no recipient, exclude the created contract instead
+		to := crypto.CreateAddress(msg.From(), msg.Nonce())
+		if msg.To() != nil {
+			to = *msg.To()
+		}
+		return logger.NewAccessListTracer(msg.AccessList(), msg.From(), to, preCompiles)
 	case TracerJSON:
 		return logger.NewJSONLogger(logCfg, os.Stderr)
 	case TracerMarkdown:
@@ -44,6 +56,13 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 	}
 }
 
+// TxAccessList contains the accounts and storage slots touched by an executed
+// transaction, together with the amount of cold and warm accesses.
+type TxAccessList struct {
+	AccessList ethtypes.AccessList `json:"accessList"`
+	Stats      logger.AccessStats  `json:"stats"`
+}
+
 // TxTraceResult is the result of a single transaction trace during a block trace.
 type TxTraceResult struct {
 	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
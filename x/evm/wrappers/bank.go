@@ -12,6 +12,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/evmos/evmos/v20/utils/dust"
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -41,7 +42,7 @@ func NewBankWrapper(
 func (w BankWrapper) MintAmountToAccount(ctx context.Context, recipientAddr sdk.AccAddress, amt *big.Int) error {
 	coin := sdk.Coin{Denom: types.GetEVMCoinDenom(), Amount: sdkmath.NewIntFromBigInt(amt)}
 
-	convertedCoin, err := types.ConvertEvmCoinFrom18Decimals(coin)
+	convertedCoin, remainder, err := types.ConvertEvmCoinFrom18DecimalsWithDust(coin)
 	if err != nil {
 		return errors.Wrap(err, "failed to mint coin to account in bank wrapper")
 	}
@@ -51,7 +52,12 @@ func (w BankWrapper) MintAmountToAccount(ctx context.Context, recipientAddr sdk.
 		return errors.Wrap(err, "failed to mint coins to account in bank wrapper")
 	}
 
-	return w.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coinsToMint)
+	if err := w.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coinsToMint); err != nil {
+		return err
+	}
+
+	trackDust(ctx, sdk.NewDecCoins(remainder))
+	return nil
 }
 
 // BurnAmountFromAccount converts the given amount into the evm coin scaling
@@ -59,7 +65,7 @@ func (w BankWrapper) MintAmountToAccount(ctx context.Context, recipientAddr sdk.
 func (w BankWrapper) BurnAmountFromAccount(ctx context.Context, account sdk.AccAddress, amt *big.Int) error {
 	coin := sdk.Coin{Denom: types.GetEVMCoinDenom(), Amount: sdkmath.NewIntFromBigInt(amt)}
 
-	convertedCoin, err := types.ConvertEvmCoinFrom18Decimals(coin)
+	convertedCoin, remainder, err := types.ConvertEvmCoinFrom18DecimalsWithDust(coin)
 	if err != nil {
 		return errors.Wrap(err, "failed to burn coins from account in bank wrapper")
 	}
@@ -68,7 +74,12 @@ func (w BankWrapper) BurnAmountFromAccount(ctx context.Context, account sdk.AccA
 	if err := w.BankKeeper.SendCoinsFromAccountToModule(ctx, account, types.ModuleName, coinsToBurn); err != nil {
 		return errors.Wrap(err, "failed to burn coins from account in bank wrapper")
 	}
-	return w.BankKeeper.BurnCoins(ctx, types.ModuleName, coinsToBurn)
+	if err := w.BankKeeper.BurnCoins(ctx, types.ModuleName, coinsToBurn); err != nil {
+		return err
+	}
+
+	trackDust(ctx, sdk.NewDecCoins(remainder))
+	return nil
 }
 
 // ------------------------------------------------------------------------------------------
@@ -89,19 +100,33 @@ func (w BankWrapper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom
 // SendCoinsFromAccountToModule method to convert the evm coin, if present in
 // the input, to its original representation.
 func (w BankWrapper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, coins sdk.Coins) error {
-	convertedCoins := types.ConvertCoinsFrom18Decimals(coins)
+	convertedCoins, remainder := types.ConvertCoinsFrom18DecimalsWithDust(coins)
 
-	return w.BankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, convertedCoins)
+	if err := w.BankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, convertedCoins); err != nil {
+		return err
+	}
+
+	trackDust(ctx, remainder)
+	return nil
 }
 
 // SendCoinsFromModuleToAccount wraps around the Cosmos SDK x/bank module's
 // SendCoinsFromModuleToAccount method to convert the evm coin, if present in
 // the input, to its original representation.
 func (w BankWrapper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, coins sdk.Coins) error {
-	convertedCoins := types.ConvertCoinsFrom18Decimals(coins)
-	if convertedCoins.IsZero() {
-		return nil
+	convertedCoins, remainder := types.ConvertCoinsFrom18DecimalsWithDust(coins)
+	if !convertedCoins.IsZero() {
+		if err := w.BankKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, convertedCoins); err != nil {
+			return err
+		}
 	}
 
-	return w.BankKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, convertedCoins)
+	trackDust(ctx, remainder)
+	return nil
+}
+
+// trackDust keeps record of the sub-unit remainder truncated when converting
+// the evm coin to its original representation.
+func trackDust(ctx context.Context, remainder sdk.DecCoins) {
+	dust.TrackDec(sdk.UnwrapSDKContext(ctx), types.ModuleName, dust.DestinationTruncated, remainder)
 }
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	utils "github.com/evmos/evmos/v20/utils"
+	"github.com/evmos/evmos/v20/utils/dust"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
 )
 
@@ -57,32 +58,48 @@ func (k Keeper) AllocateExponentialInflation(
 ) {
 	distribution := params.InflationDistribution
 
-	// Allocate staking rewards into fee collector account
-	staking = sdk.Coins{k.GetProportions(ctx, mintedCoin, distribution.StakingRewards)}
-
-	if err := k.bankKeeper.SendCoinsFromModuleToModule(
-		ctx,
-		types.ModuleName,
-		k.feeCollectorName,
-		staking,
-	); err != nil {
-		return nil, nil, err
-	}
+	// Split the minted amount following the shared dust policy. The remainder of
+	// the truncated allocations is sent to the destination set in the params.
+	shares, dustAmt := dust.Split(mintedCoin.Amount, distribution.StakingRewards, distribution.CommunityPool)
+	dustCoins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, dustAmt))
 
-	// Allocate community pool amount (remaining module balance) to community
-	// pool address
-	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
-	communityPool = k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+	staking = sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, shares[0]))
+	communityPool = sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, shares[1]))
 
-	err = k.distrKeeper.FundCommunityPool(
-		ctx,
-		communityPool,
-		moduleAddr,
-	)
-	if err != nil {
-		return nil, nil, err
+	dustDestination := dust.DestinationCommunityPool
+	if params.DustDestination == types.DustDestinationStakingRewards {
+		dustDestination = dust.DestinationStakingRewards
+		staking = staking.Add(dustCoins...)
+	} else {
+		communityPool = communityPool.Add(dustCoins...)
 	}
 
+	// Allocate staking rewards into fee collector account
+	if !staking.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			types.ModuleName,
+			k.feeCollectorName,
+			staking,
+		); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Allocate community pool amount to community pool address
+	if !communityPool.IsZero() {
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		if err := k.distrKeeper.FundCommunityPool(
+			ctx,
+			communityPool,
+			moduleAddr,
+		); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	dust.Track(ctx, types.ModuleName, dustDestination, dustCoins)
+
 	return staking, communityPool, nil
 }
 
@@ -93,9 +110,10 @@ func (k Keeper) GetProportions(
 	coin sdk.Coin,
 	distribution math.LegacyDec,
 ) sdk.Coin {
+	amount, _ := dust.MulDec(coin.Amount, distribution)
 	return sdk.Coin{
 		Denom:  coin.Denom,
-		Amount: math.LegacyNewDecFromInt(coin.Amount).Mul(distribution).TruncateInt(),
+		Amount: amount,
 	}
 }
 
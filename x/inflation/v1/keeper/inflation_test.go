@@ -12,6 +12,7 @@ import (
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/utils"
+	"github.com/evmos/evmos/v20/utils/dust"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
 	"github.com/stretchr/testify/require"
 )
@@ -92,6 +93,90 @@ func TestMintAndAllocateInflation(t *testing.T) {
 	}
 }
 
+func TestAllocateInflationDust(t *testing.T) {
+	testCases := []struct {
+		name                string
+		mintCoin            sdk.Coin
+		dustDestination     types.DustDestination
+		expStakingRewardAmt math.Int
+		expCommunityPoolAmt math.Int
+		expDust             string
+	}{
+		{
+			"dust sent to the community pool",
+			sdk.NewCoin(denomMint, math.NewInt(1_000_000)),
+			types.DustDestinationCommunityPool,
+			math.NewInt(533_333),
+			math.NewInt(466_667),
+			dust.DestinationCommunityPool,
+		},
+		{
+			"dust added to the staking rewards",
+			sdk.NewCoin(denomMint, math.NewInt(1_000_000)),
+			types.DustDestinationStakingRewards,
+			math.NewInt(533_334),
+			math.NewInt(466_666),
+			dust.DestinationStakingRewards,
+		},
+		{
+			"no dust",
+			sdk.NewCoin(denomMint, math.NewInt(1_000_000_000)),
+			types.DustDestinationCommunityPool,
+			math.NewInt(533_333_334),
+			math.NewInt(466_666_666),
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext().WithEventManager(sdk.NewEventManager())
+
+			params := types.DefaultParams()
+			params.DustDestination = tc.dustDestination
+
+			staking, communityPool, err := nw.App.InflationKeeper.MintAndAllocateInflation(ctx, tc.mintCoin, params)
+			require.NoError(t, err)
+			require.Equal(t, tc.expStakingRewardAmt, staking.AmountOf(denomMint))
+			require.Equal(t, tc.expCommunityPoolAmt, communityPool.AmountOf(denomMint))
+
+			// the minted coins are fully allocated
+			balanceModule := nw.App.BankKeeper.GetBalance(ctx, nw.App.AccountKeeper.GetModuleAddress(types.ModuleName), denomMint)
+			require.True(t, balanceModule.IsZero())
+
+			feeCollector := nw.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			balanceStakingRewards := nw.App.BankKeeper.GetBalance(ctx, feeCollector, denomMint)
+			require.Equal(t, tc.expStakingRewardAmt, balanceStakingRewards.Amount)
+
+			pool, err := nw.App.DistrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, math.LegacyNewDecFromInt(tc.expCommunityPoolAmt), pool.CommunityPool.AmountOf(denomMint))
+
+			var dustEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == dust.EventTypeDust {
+					dustEvents = append(dustEvents, event)
+				}
+			}
+			if tc.expDust == "" {
+				require.Empty(t, dustEvents)
+				return
+			}
+
+			require.Len(t, dustEvents, 1)
+			source, ok := dustEvents[0].GetAttribute(dust.AttributeKeySource)
+			require.True(t, ok)
+			require.Equal(t, types.ModuleName, source.Value)
+			destination, ok := dustEvents[0].GetAttribute(dust.AttributeKeyDestination)
+			require.True(t, ok)
+			require.Equal(t, tc.expDust, destination.Value)
+			amount, ok := dustEvents[0].GetAttribute(sdk.AttributeKeyAmount)
+			require.True(t, ok)
+			require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(denomMint, math.OneInt())).String(), amount.Value)
+		})
+	}
+}
+
 func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 	var (
 		ctx sdk.Context
@@ -116,6 +116,8 @@ type Params struct {
 	InflationDistribution InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution"`
 	// enable_inflation is the parameter that enables inflation and halts increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,4,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// dust_destination defines where the remainder of the truncated allocations is sent
+	DustDestination DustDestination `protobuf:"varint,5,opt,name=dust_destination,json=dustDestination,proto3,enum=evmos.inflation.v1.DustDestination" json:"dust_destination,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -179,6 +181,13 @@ func (m *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (m *Params) GetDustDestination() DustDestination {
+	if m != nil {
+		return m.DustDestination
+	}
+	return DustDestinationCommunityPool
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.inflation.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.inflation.v1.Params")
@@ -187,34 +196,36 @@ func init() {
 func init() { proto.RegisterFile("evmos/inflation/v1/genesis.proto", fileDescriptor_1cb8eee530db1235) }
 
 var fileDescriptor_1cb8eee530db1235 = []byte{
-	// 431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x8d, 0x9b, 0x12, 0x11, 0x17, 0x28, 0xb5, 0x20, 0x44, 0x91, 0x58, 0x56, 0x91, 0x90, 0xd2,
-	0x1c, 0xd6, 0x34, 0x9c, 0xb9, 0x94, 0x56, 0x28, 0xb7, 0x68, 0xb9, 0x71, 0xb1, 0x9c, 0xec, 0x34,
-	0xb5, 0x9a, 0xb5, 0xad, 0xb5, 0x13, 0x95, 0xbf, 0xe0, 0x33, 0xb8, 0xc1, 0x67, 0xf4, 0xd8, 0x23,
-	0x27, 0x84, 0x92, 0x03, 0xbf, 0x81, 0x32, 0x5e, 0x36, 0x41, 0xec, 0xc5, 0xb2, 0xdf, 0xbc, 0x79,
-	0x6f, 0x66, 0x3c, 0x34, 0x86, 0x55, 0x6e, 0x1c, 0x57, 0xfa, 0x6a, 0x21, 0xbd, 0x32, 0x9a, 0xaf,
-	0xce, 0xf8, 0x1c, 0x34, 0x38, 0xe5, 0x12, 0x5b, 0x18, 0x6f, 0x18, 0x43, 0x46, 0x52, 0x31, 0x92,
-	0xd5, 0x59, 0xef, 0x44, 0xe6, 0x4a, 0x1b, 0x8e, 0x67, 0xa0, 0xf5, 0x9e, 0xcd, 0xcd, 0xdc, 0xe0,
-	0x95, 0x6f, 0x6f, 0x25, 0xda, 0xaf, 0x91, 0xdf, 0x29, 0x21, 0xa7, 0xbf, 0x21, 0xf4, 0xd1, 0x87,
-	0x60, 0xf9, 0xd1, 0x4b, 0x0f, 0xec, 0x1d, 0x6d, 0x59, 0x59, 0xc8, 0xdc, 0x75, 0x49, 0x4c, 0x06,
-	0x47, 0xa3, 0x5e, 0xf2, 0x7f, 0x09, 0xc9, 0x04, 0x19, 0xe7, 0xed, 0xbb, 0x9f, 0xaf, 0x1a, 0x5f,
-	0x7f, 0x7f, 0x1f, 0x92, 0xb4, 0x4c, 0x62, 0x1d, 0xda, 0xb2, 0x50, 0x28, 0x93, 0x75, 0x0f, 0x62,
-	0x32, 0x38, 0x4c, 0xcb, 0x17, 0x3b, 0xa5, 0x4f, 0xc1, 0x9a, 0xd9, 0xb5, 0x50, 0x19, 0x68, 0xaf,
-	0xae, 0x14, 0x14, 0xdd, 0x66, 0x4c, 0x06, 0xed, 0xf4, 0x18, 0xf1, 0x71, 0x05, 0xb3, 0x21, 0x3d,
-	0x41, 0xc8, 0x09, 0x0b, 0x85, 0x28, 0xd5, 0x0e, 0x63, 0x32, 0x68, 0x96, 0x5c, 0x37, 0x81, 0x62,
-	0x12, 0x64, 0x5f, 0xd3, 0x27, 0xee, 0x46, 0x59, 0x0b, 0x99, 0x08, 0xa1, 0xee, 0x03, 0xb4, 0x7d,
-	0x5c, 0xa2, 0x97, 0x08, 0xf6, 0xbf, 0x1d, 0xd0, 0x56, 0xa8, 0x99, 0xbd, 0xa4, 0x34, 0x57, 0xda,
-	0x8b, 0x0c, 0xb4, 0xc9, 0xb1, 0xc7, 0x76, 0xda, 0xde, 0x22, 0x17, 0x5b, 0x80, 0x69, 0xfa, 0x02,
-	0x6e, 0xad, 0xd1, 0xdb, 0x6a, 0xe4, 0x42, 0xcc, 0xe4, 0x62, 0xb6, 0x0c, 0x7d, 0x63, 0x43, 0x47,
-	0xa3, 0x61, 0xdd, 0x3c, 0x2e, 0x77, 0x29, 0xef, 0x77, 0x19, 0xfb, 0xf3, 0xe9, 0x40, 0x2d, 0x85,
-	0xdd, 0xd0, 0x4e, 0xa5, 0x24, 0x32, 0xe5, 0x7c, 0xa1, 0xa6, 0x4b, 0xb4, 0x6b, 0xa2, 0xdd, 0x69,
-	0x9d, 0xdd, 0xf8, 0xef, 0xe3, 0x62, 0x2f, 0x61, 0xdf, 0xed, 0xb9, 0xaa, 0x63, 0xe0, 0x27, 0x68,
-	0x39, 0x5d, 0x80, 0xa8, 0xe2, 0x38, 0xd8, 0x87, 0xe9, 0x71, 0xc0, 0x2b, 0xe1, 0xf3, 0xf1, 0xdd,
-	0x3a, 0x22, 0xf7, 0xeb, 0x88, 0xfc, 0x5a, 0x47, 0xe4, 0xcb, 0x26, 0x6a, 0xdc, 0x6f, 0xa2, 0xc6,
-	0x8f, 0x4d, 0xd4, 0xf8, 0xc4, 0xe7, 0xca, 0x5f, 0x2f, 0xa7, 0xc9, 0xcc, 0xe4, 0x3c, 0x2c, 0x58,
-	0x38, 0x57, 0xa3, 0x37, 0xfc, 0xf6, 0xdf, 0x65, 0xf3, 0x9f, 0x2d, 0xb8, 0x69, 0x0b, 0x37, 0xed,
-	0xed, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x47, 0x3c, 0x80, 0xee, 0x02, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0x36, 0x25, 0x22, 0x5b, 0x68, 0xda, 0x15, 0x84, 0x28, 0x12, 0xc6, 0x0a, 0x42, 0x4a,
+	0x73, 0xb0, 0x69, 0x38, 0x73, 0x29, 0xa9, 0x50, 0x2e, 0x28, 0x32, 0x37, 0x2e, 0x96, 0x13, 0x4f,
+	0xd3, 0x55, 0xe3, 0xdd, 0x95, 0x77, 0x1d, 0x95, 0xbf, 0xe0, 0x33, 0x38, 0xf2, 0x19, 0x3d, 0xf6,
+	0xc8, 0x09, 0xa1, 0xe4, 0xc0, 0x67, 0x80, 0x3c, 0x6b, 0xec, 0x44, 0xf5, 0xc5, 0xda, 0x7d, 0xfb,
+	0xe6, 0xbd, 0x99, 0xe7, 0xa1, 0x2e, 0xac, 0x13, 0xa9, 0x7d, 0x2e, 0xae, 0x56, 0x91, 0xe1, 0x52,
+	0xf8, 0xeb, 0x73, 0x7f, 0x09, 0x02, 0x34, 0xd7, 0x9e, 0x4a, 0xa5, 0x91, 0x8c, 0x21, 0xc3, 0x2b,
+	0x19, 0xde, 0xfa, 0xbc, 0x7f, 0x1a, 0x25, 0x5c, 0x48, 0x1f, 0xbf, 0x96, 0xd6, 0x7f, 0xb6, 0x94,
+	0x4b, 0x89, 0x47, 0x3f, 0x3f, 0x15, 0xe8, 0xa0, 0x46, 0xbe, 0x52, 0x42, 0xce, 0x60, 0x4b, 0xe8,
+	0x93, 0x8f, 0xd6, 0xf2, 0xb3, 0x89, 0x0c, 0xb0, 0xf7, 0xb4, 0xa5, 0xa2, 0x34, 0x4a, 0x74, 0x8f,
+	0xb8, 0x64, 0x78, 0x34, 0xee, 0x7b, 0x0f, 0x5b, 0xf0, 0x66, 0xc8, 0xb8, 0x68, 0xdf, 0xfd, 0x7a,
+	0xd5, 0xf8, 0xfe, 0xe7, 0xc7, 0x88, 0x04, 0x45, 0x11, 0xeb, 0xd2, 0x96, 0x82, 0x94, 0xcb, 0xb8,
+	0x77, 0xe0, 0x92, 0xe1, 0x61, 0x50, 0xdc, 0xd8, 0x19, 0x3d, 0x01, 0x25, 0x17, 0xd7, 0x21, 0x8f,
+	0x41, 0x18, 0x7e, 0xc5, 0x21, 0xed, 0x35, 0x5d, 0x32, 0x6c, 0x07, 0x1d, 0xc4, 0xa7, 0x25, 0xcc,
+	0x46, 0xf4, 0x14, 0x21, 0x1d, 0x2a, 0x48, 0xc3, 0x42, 0xed, 0xd0, 0x25, 0xc3, 0x66, 0xc1, 0xd5,
+	0x33, 0x48, 0x67, 0x56, 0xf6, 0x0d, 0x3d, 0xd6, 0x37, 0x5c, 0x29, 0x88, 0x43, 0xfb, 0xd4, 0x7b,
+	0x84, 0xb6, 0x4f, 0x0b, 0xf4, 0x12, 0xc1, 0xc1, 0xdf, 0x03, 0xda, 0xb2, 0x3d, 0xb3, 0x97, 0x94,
+	0x26, 0x5c, 0x98, 0x30, 0x06, 0x21, 0x13, 0x9c, 0xb1, 0x1d, 0xb4, 0x73, 0x64, 0x92, 0x03, 0x4c,
+	0xd0, 0x17, 0x70, 0xab, 0xa4, 0xc8, 0xbb, 0x89, 0x56, 0xe1, 0x22, 0x5a, 0x2d, 0x32, 0x3b, 0x37,
+	0x0e, 0x74, 0x34, 0x1e, 0xd5, 0xe5, 0x71, 0x59, 0x95, 0x7c, 0xa8, 0x2a, 0x76, 0xf3, 0xe9, 0x42,
+	0x2d, 0x85, 0xdd, 0xd0, 0x6e, 0xa9, 0x14, 0xc6, 0x5c, 0x9b, 0x94, 0xcf, 0x33, 0xb4, 0x6b, 0xa2,
+	0xdd, 0x59, 0x9d, 0xdd, 0xf4, 0xff, 0x65, 0xb2, 0x53, 0xb0, 0xeb, 0xf6, 0x9c, 0xd7, 0x31, 0xf0,
+	0x27, 0x88, 0x68, 0xbe, 0x82, 0xb0, 0x7c, 0xc7, 0x60, 0x1f, 0x07, 0x1d, 0x8b, 0x97, 0xc2, 0xec,
+	0x13, 0x3d, 0x89, 0x33, 0x9d, 0xc7, 0xa4, 0x0d, 0x17, 0x96, 0x9a, 0x47, 0x7b, 0x3c, 0x7e, 0x5d,
+	0xd7, 0xd1, 0x24, 0xd3, 0x66, 0x52, 0x51, 0x83, 0x4e, 0xbc, 0x0f, 0x5c, 0x4c, 0xef, 0x36, 0x0e,
+	0xb9, 0xdf, 0x38, 0xe4, 0xf7, 0xc6, 0x21, 0xdf, 0xb6, 0x4e, 0xe3, 0x7e, 0xeb, 0x34, 0x7e, 0x6e,
+	0x9d, 0xc6, 0x17, 0x7f, 0xc9, 0xcd, 0x75, 0x36, 0xf7, 0x16, 0x32, 0xf1, 0xed, 0xc2, 0xda, 0xef,
+	0x7a, 0xfc, 0xd6, 0xbf, 0xdd, 0x5f, 0x5e, 0xf3, 0x55, 0x81, 0x9e, 0xb7, 0x70, 0x73, 0xdf, 0xfd,
+	0x1b, 0x00, 0xa1, 0x33, 0xc1, 0x1b, 0x3e, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -292,6 +303,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DustDestination != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DustDestination))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.EnableInflation {
 		i--
 		if m.EnableInflation {
@@ -384,6 +400,9 @@ func (m *Params) Size() (n int) {
 	if m.EnableInflation {
 		n += 2
 	}
+	if m.DustDestination != 0 {
+		n += 1 + sovGenesis(uint64(m.DustDestination))
+	}
 	return n
 }
 
@@ -712,6 +731,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableInflation = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustDestination", wireType)
+			}
+			m.DustDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustDestination |= DustDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
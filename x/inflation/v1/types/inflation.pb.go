@@ -25,6 +25,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DustDestination defines where the remainder (dust) left by the truncation of
+// the inflation allocations is sent.
+type DustDestination int32
+
+const (
+	// DUST_DESTINATION_COMMUNITY_POOL sends the dust to the community pool.
+	DustDestinationCommunityPool DustDestination = 0
+	// DUST_DESTINATION_STAKING_REWARDS adds the dust to the staking rewards.
+	DustDestinationStakingRewards DustDestination = 1
+)
+
+var DustDestination_name = map[int32]string{
+	0: "DUST_DESTINATION_COMMUNITY_POOL",
+	1: "DUST_DESTINATION_STAKING_REWARDS",
+}
+
+var DustDestination_value = map[string]int32{
+	"DUST_DESTINATION_COMMUNITY_POOL":  0,
+	"DUST_DESTINATION_STAKING_REWARDS": 1,
+}
+
+func (x DustDestination) String() string {
+	return proto.EnumName(DustDestination_name, int32(x))
+}
+
+func (DustDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{0}
+}
+
 // InflationDistribution defines the distribution in which inflation is
 // allocated through minting on each epoch (staking, incentives, community). It
 // excludes the team vesting distribution, as this is minted once at genesis.
@@ -129,6 +158,7 @@ func (m *ExponentialCalculation) XXX_DiscardUnknown() {
 var xxx_messageInfo_ExponentialCalculation proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("evmos.inflation.v1.DustDestination", DustDestination_name, DustDestination_value)
 	proto.RegisterType((*InflationDistribution)(nil), "evmos.inflation.v1.InflationDistribution")
 	proto.RegisterType((*ExponentialCalculation)(nil), "evmos.inflation.v1.ExponentialCalculation")
 }
@@ -138,32 +168,38 @@ func init() {
 }
 
 var fileDescriptor_d064cb35c3ff7df8 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x8e, 0xd3, 0x30,
-	0x10, 0xc7, 0xe3, 0xf2, 0x21, 0x61, 0x60, 0x17, 0x22, 0x40, 0xd1, 0x22, 0x65, 0x51, 0x4f, 0xab,
-	0x3d, 0xc4, 0x14, 0x24, 0x1e, 0xa0, 0x94, 0x43, 0x05, 0x12, 0x50, 0x01, 0x07, 0x2e, 0xd1, 0xc4,
-	0x35, 0xa9, 0xd5, 0xd8, 0x13, 0xd9, 0x4e, 0x48, 0xdf, 0x02, 0xf1, 0x14, 0x1c, 0x79, 0x8c, 0x1e,
-	0x7b, 0x44, 0x1c, 0x2a, 0xd4, 0x1e, 0x78, 0x0d, 0x94, 0x0f, 0x5a, 0x71, 0x23, 0x97, 0xd1, 0x64,
-	0x34, 0xbf, 0x7f, 0x3c, 0x7f, 0x8f, 0xe9, 0x50, 0x94, 0x0a, 0x2d, 0x93, 0xfa, 0x53, 0x06, 0x4e,
-	0xa2, 0x66, 0xe5, 0xe8, 0xf8, 0x11, 0xe5, 0x06, 0x1d, 0xfa, 0x7e, 0xd3, 0x13, 0x1d, 0xcb, 0xe5,
-	0xe8, 0xec, 0x2e, 0x28, 0xa9, 0x91, 0x35, 0xb1, 0x6d, 0x3b, 0xbb, 0x97, 0x62, 0x8a, 0x4d, 0xca,
-	0xea, 0xac, 0xad, 0x0e, 0xbf, 0x0e, 0xe8, 0xfd, 0xe9, 0x5f, 0x72, 0x22, 0xad, 0x33, 0x32, 0x29,
-	0xea, 0xdc, 0x7f, 0x4b, 0x4f, 0xad, 0x83, 0xa5, 0xd4, 0x69, 0x6c, 0xc4, 0x67, 0x30, 0x73, 0x1b,
-	0x90, 0x47, 0xe4, 0xe2, 0xc6, 0xf8, 0x62, 0xbd, 0x3d, 0xf7, 0x7e, 0x6e, 0xcf, 0x1f, 0x72, 0xb4,
-	0x0a, 0xad, 0x9d, 0x2f, 0x23, 0x89, 0x4c, 0x81, 0x5b, 0x44, 0xaf, 0x44, 0x0a, 0x7c, 0x35, 0x11,
-	0xfc, 0xdb, 0xef, 0xef, 0x97, 0x64, 0x76, 0xd2, 0x09, 0xcc, 0x5a, 0xde, 0x7f, 0x4f, 0xef, 0x14,
-	0x16, 0x52, 0x11, 0x4b, 0xcd, 0x85, 0x76, 0xb2, 0x14, 0x36, 0x18, 0x34, 0x9a, 0x97, 0xff, 0xab,
-	0x19, 0x90, 0xd9, 0x69, 0xa3, 0x31, 0x3d, 0x48, 0xf8, 0xaf, 0xe9, 0x09, 0x47, 0xa5, 0x0a, 0x2d,
-	0xdd, 0x2a, 0xce, 0x11, 0xb3, 0xe0, 0x4a, 0xcf, 0x83, 0xde, 0x3e, 0xf0, 0x6f, 0x10, 0xb3, 0xe1,
-	0x76, 0x40, 0x1f, 0xbc, 0xa8, 0x72, 0xd4, 0xf5, 0x1f, 0x20, 0x7b, 0x0e, 0x19, 0x2f, 0x5a, 0x87,
-	0xfc, 0x67, 0x94, 0x40, 0x6f, 0x1f, 0x08, 0xd4, 0x9c, 0xe9, 0x66, 0xed, 0xc1, 0x99, 0x9a, 0xe3,
-	0xbd, 0xc7, 0x21, 0xbc, 0xf6, 0x24, 0x41, 0x3d, 0xaf, 0x6f, 0xcf, 0x81, 0x49, 0x85, 0x0b, 0xae,
-	0xf6, 0xf5, 0xa4, 0xe3, 0xdf, 0x35, 0xb8, 0xff, 0x92, 0xde, 0x52, 0x50, 0xc5, 0x25, 0x18, 0x09,
-	0x9a, 0x8b, 0xe0, 0x5a, 0x4f, 0xb9, 0x9b, 0x0a, 0xaa, 0x0f, 0x1d, 0x3c, 0x9e, 0xae, 0x77, 0x21,
-	0xd9, 0xec, 0x42, 0xf2, 0x6b, 0x17, 0x92, 0x2f, 0xfb, 0xd0, 0xdb, 0xec, 0x43, 0xef, 0xc7, 0x3e,
-	0xf4, 0x3e, 0xb2, 0x54, 0xba, 0x45, 0x91, 0x44, 0x1c, 0x15, 0x6b, 0x77, 0xbf, 0x8d, 0xe5, 0x93,
-	0xc7, 0xac, 0xfa, 0xf7, 0x1d, 0xb8, 0x55, 0x2e, 0x6c, 0x72, 0xbd, 0xd9, 0xe3, 0xa7, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xec, 0x57, 0xae, 0x03, 0x2a, 0x03, 0x00, 0x00,
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xb1, 0x6e, 0xd3, 0x40,
+	0x18, 0xc7, 0x7d, 0xa1, 0x20, 0x71, 0x40, 0x1b, 0x2c, 0x40, 0x56, 0x00, 0x27, 0x64, 0xaa, 0x32,
+	0xd8, 0x14, 0x24, 0xf6, 0x34, 0x8e, 0x2a, 0xab, 0x6d, 0x5c, 0x6c, 0x07, 0x04, 0x8b, 0x75, 0xb9,
+	0x1c, 0xee, 0xa9, 0xf6, 0x5d, 0xe4, 0x3b, 0x9b, 0xe4, 0x0d, 0x50, 0x27, 0xc4, 0xde, 0x89, 0x01,
+	0x46, 0x1e, 0xa3, 0x63, 0x47, 0xc4, 0x10, 0xa1, 0x64, 0xe0, 0x35, 0x90, 0xed, 0xd0, 0xd2, 0xb2,
+	0xe0, 0xe5, 0xf4, 0xf9, 0x74, 0xbf, 0x9f, 0xed, 0xff, 0x77, 0x1f, 0x6c, 0x93, 0x2c, 0xe6, 0xc2,
+	0xa4, 0xec, 0x5d, 0x84, 0x24, 0xe5, 0xcc, 0xcc, 0xb6, 0x2e, 0x1e, 0x8c, 0x49, 0xc2, 0x25, 0x57,
+	0xd5, 0xe2, 0x8c, 0x71, 0xb1, 0x9d, 0x6d, 0x35, 0xee, 0xa2, 0x98, 0x32, 0x6e, 0x16, 0x6b, 0x79,
+	0xac, 0x71, 0x2f, 0xe4, 0x21, 0x2f, 0x4a, 0x33, 0xaf, 0xca, 0xdd, 0xf6, 0xa7, 0x1a, 0xbc, 0x6f,
+	0xff, 0x21, 0x2d, 0x2a, 0x64, 0x42, 0x47, 0x69, 0x5e, 0xab, 0x2f, 0xe1, 0x86, 0x90, 0xe8, 0x88,
+	0xb2, 0x30, 0x48, 0xc8, 0x7b, 0x94, 0x8c, 0x85, 0x06, 0x5a, 0x60, 0xf3, 0xe6, 0xf6, 0xe6, 0xe9,
+	0xbc, 0xa9, 0xfc, 0x98, 0x37, 0x1f, 0x62, 0x2e, 0x62, 0x2e, 0xc4, 0xf8, 0xc8, 0xa0, 0xdc, 0x8c,
+	0x91, 0x3c, 0x34, 0xf6, 0x48, 0x88, 0xf0, 0xcc, 0x22, 0xf8, 0xeb, 0xaf, 0x6f, 0x1d, 0xe0, 0xae,
+	0xaf, 0x04, 0x6e, 0xc9, 0xab, 0x43, 0x58, 0x4f, 0x05, 0x0a, 0x49, 0x40, 0x19, 0x26, 0x4c, 0xd2,
+	0x8c, 0x08, 0xad, 0x56, 0x38, 0x3b, 0xff, 0xeb, 0xd4, 0x80, 0xbb, 0x51, 0x38, 0xec, 0x73, 0x85,
+	0xea, 0xc0, 0x75, 0xcc, 0xe3, 0x38, 0x65, 0x54, 0xce, 0x82, 0x09, 0xe7, 0x91, 0x76, 0xad, 0xe2,
+	0x87, 0xde, 0x39, 0xe7, 0x0f, 0x38, 0x8f, 0xda, 0xf3, 0x1a, 0x7c, 0xd0, 0x9f, 0x4e, 0x38, 0xcb,
+	0xdf, 0x80, 0xa2, 0x1e, 0x8a, 0x70, 0x5a, 0x26, 0xa4, 0xbe, 0x80, 0x00, 0x55, 0xce, 0x01, 0xa0,
+	0x9c, 0x4b, 0xb4, 0x5a, 0x55, 0x2e, 0xc9, 0x39, 0x5c, 0xf9, 0x77, 0x00, 0xce, 0x33, 0x19, 0x71,
+	0x36, 0xce, 0xbb, 0x27, 0x51, 0x12, 0x12, 0xa9, 0xad, 0x55, 0xcd, 0x64, 0xc5, 0xfb, 0x05, 0xae,
+	0xee, 0xc2, 0xdb, 0x31, 0x9a, 0x06, 0x19, 0x4a, 0x28, 0x62, 0x98, 0x68, 0xd7, 0x2b, 0xea, 0x6e,
+	0xc5, 0x68, 0xfa, 0x6a, 0x05, 0x77, 0xbe, 0x00, 0xb8, 0x61, 0xa5, 0x42, 0x5a, 0x44, 0x48, 0xca,
+	0xca, 0x64, 0xfb, 0xb0, 0x69, 0x0d, 0x3d, 0x3f, 0xb0, 0xfa, 0x9e, 0x6f, 0x0f, 0xba, 0xbe, 0xed,
+	0x0c, 0x82, 0x9e, 0xb3, 0xbf, 0x3f, 0x1c, 0xd8, 0xfe, 0x9b, 0xe0, 0xc0, 0x71, 0xf6, 0xea, 0x4a,
+	0xa3, 0x75, 0x7c, 0xd2, 0x7a, 0x74, 0x85, 0xec, 0xfd, 0xdd, 0x3b, 0x75, 0x07, 0xb6, 0xfe, 0xd1,
+	0x78, 0x7e, 0x77, 0xd7, 0x1e, 0xec, 0x04, 0x6e, 0xff, 0x75, 0xd7, 0xb5, 0xbc, 0x3a, 0x68, 0x3c,
+	0x39, 0x3e, 0x69, 0x3d, 0xbe, 0xe2, 0xf1, 0x2e, 0x5d, 0xd6, 0xc6, 0xda, 0x87, 0xcf, 0xba, 0xb2,
+	0x6d, 0x9f, 0x2e, 0x74, 0x70, 0xb6, 0xd0, 0xc1, 0xcf, 0x85, 0x0e, 0x3e, 0x2e, 0x75, 0xe5, 0x6c,
+	0xa9, 0x2b, 0xdf, 0x97, 0xba, 0xf2, 0xd6, 0x0c, 0xa9, 0x3c, 0x4c, 0x47, 0x06, 0xe6, 0xb1, 0x59,
+	0x4e, 0x69, 0xb9, 0x66, 0xcf, 0x9e, 0x9a, 0xd3, 0xcb, 0x13, 0x2b, 0x67, 0x13, 0x22, 0x46, 0x37,
+	0x8a, 0x89, 0x7b, 0xfe, 0x7b, 0x00, 0x6d, 0x4c, 0x5a, 0xd1, 0xd4, 0x03, 0x00, 0x00,
 }
 
 func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -40,6 +40,7 @@ var (
 		CommunityPool:   math.LegacyNewDecWithPrec(466666666, 9), // 0.47
 		UsageIncentives: math.LegacyZeroDec(),                    // Deprecated
 	}
+	DefaultDustDestination = DustDestinationCommunityPool
 )
 
 func NewParams(
@@ -63,6 +64,7 @@ func DefaultParams() Params {
 		ExponentialCalculation: DefaultExponentialCalculation,
 		InflationDistribution:  DefaultInflationDistribution,
 		EnableInflation:        DefaultInflation,
+		DustDestination:        DefaultDustDestination,
 	}
 }
 
@@ -147,6 +149,19 @@ func validateInflationDistribution(i interface{}) error {
 	return nil
 }
 
+func validateDustDestination(i interface{}) error {
+	v, ok := i.(DustDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := DustDestination_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid dust destination: %d", v)
+	}
+
+	return nil
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -166,6 +181,9 @@ func (p Params) Validate() error {
 	if err := validateInflationDistribution(p.InflationDistribution); err != nil {
 		return err
 	}
+	if err := validateDustDestination(p.DustDestination); err != nil {
+		return err
+	}
 
 	return validateBool(p.EnableInflation)
 }
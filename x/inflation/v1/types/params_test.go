@@ -60,6 +60,28 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			},
 			false,
 		},
+		{
+			"valid - dust to staking rewards",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				DustDestination:        DustDestinationStakingRewards,
+			},
+			false,
+		},
+		{
+			"invalid - dust destination",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				DustDestination:        DustDestination(2),
+			},
+			true,
+		},
 		{
 			"invalid - denom",
 			NewParams(
@@ -0,0 +1,76 @@
+package staking_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/staking"
+	evmosstakingtypes "github.com/evmos/evmos/v20/x/staking/types"
+)
+
+func TestExportGenesis(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    evmosstakingtypes.ValidatorSetParams
+		expParams bool
+	}{
+		{
+			"default params are not exported",
+			evmosstakingtypes.DefaultValidatorSetParams(),
+			false,
+		},
+		{
+			"enabled params are exported",
+			evmosstakingtypes.NewValidatorSetParams(math.LegacyNewDecWithPrec(2, 1), 50, 5),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+			cdc := nw.App.AppCodec()
+			am := staking.NewAppModule(cdc, &nw.App.StakingKeeper, nw.App.AccountKeeper, nw.App.BankKeeper, nil)
+
+			require.NoError(t, nw.App.StakingKeeper.SetValidatorSetParams(ctx, tc.params))
+			basic := staking.AppModuleBasic{AppModuleBasic: &sdkstaking.AppModuleBasic{}}
+			bz := am.ExportGenesis(ctx, cdc)
+			require.NoError(t, basic.ValidateGenesis(cdc, nil, bz))
+
+			var fields map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(bz, &fields))
+			paramsBz, found := fields["validator_set_params"]
+			require.Equal(t, tc.expParams, found)
+			if !tc.expParams {
+				return
+			}
+
+			var params evmosstakingtypes.ValidatorSetParams
+			require.NoError(t, cdc.UnmarshalJSON(paramsBz, &params))
+			require.Equal(t, tc.params, params)
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	cdc := nw.App.AppCodec()
+	basic := staking.AppModuleBasic{AppModuleBasic: &sdkstaking.AppModuleBasic{}}
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(basic.DefaultGenesis(cdc), &fields))
+
+	params := evmosstakingtypes.NewValidatorSetParams(math.LegacyNewDec(2), 0, 0)
+	fields["validator_set_params"] = cdc.MustMarshalJSON(&params)
+	bz, err := json.Marshal(fields)
+	require.NoError(t, err)
+
+	err = basic.ValidateGenesis(cdc, nil, bz)
+	require.ErrorContains(t, err, "max power percent")
+}
@@ -16,6 +16,9 @@ type Keeper struct {
 	*stakingkeeper.Keeper
 	ak types.AccountKeeper
 	bk types.BankKeeper
+
+	cdc          codec.BinaryCodec
+	storeService storetypes.KVStoreService
 }
 
 // NewKeeper creates a new staking Keeper wrapper instance.
@@ -32,5 +35,7 @@ func NewKeeper(
 		stakingkeeper.NewKeeper(cdc, storeService, ak, bk, authority, validatorAddressCodec, consensusAddressCodec),
 		ak,
 		bk,
+		cdc,
+		storeService,
 	}
 }
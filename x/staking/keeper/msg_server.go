@@ -5,15 +5,20 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	sdkstakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/evmos/evmos/v20/utils/dust"
+	evmosstakingtypes "github.com/evmos/evmos/v20/x/staking/types"
 	vestingtypes "github.com/evmos/evmos/v20/x/vesting/types"
 )
 
@@ -23,7 +28,10 @@ type msgServer struct {
 	*Keeper
 }
 
-var _ types.MsgServer = msgServer{}
+var (
+	_ types.MsgServer             = msgServer{}
+	_ evmosstakingtypes.MsgServer = &Keeper{}
+)
 
 // NewMsgServerImpl returns an implementation of the staking MsgServer interface
 // for the provided Keeper.
@@ -59,6 +67,103 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	return k.MsgServer.CreateValidator(goCtx, msg)
 }
 
+// Undelegate defines a method for performing an undelegation from a delegator and a validator.
+// The method relays the message to the Cosmos SDK staking method and tracks the sub-unit
+// difference between the token value of the unbonded shares and the undelegated amount,
+// produced by the truncation of the shares to tokens conversion. The dust stays with the
+// validator, so the token value of the remaining delegations is left unchanged.
+func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the validator is read without charging gas, so that tracking the dust does
+	// not change the gas cost of the undelegation
+	gasFreeCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		// let the Cosmos SDK staking method surface the error
+		return k.MsgServer.Undelegate(goCtx, msg)
+	}
+
+	validator, err := k.GetValidator(gasFreeCtx, valAddr)
+	if err != nil {
+		return k.MsgServer.Undelegate(goCtx, msg)
+	}
+
+	res, err := k.MsgServer.Undelegate(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the validator is removed once all its shares are unbonded
+	unbondedShares := validator.DelegatorShares
+	updatedValidator, err := k.GetValidator(gasFreeCtx, valAddr)
+	switch {
+	case err == nil:
+		unbondedShares = validator.DelegatorShares.Sub(updatedValidator.DelegatorShares)
+	case !errors.Is(err, types.ErrNoValidatorFound):
+		return nil, err
+	}
+
+	remainder := validator.TokensFromShares(unbondedShares).Sub(math.LegacyNewDecFromInt(res.Amount.Amount))
+	if remainder.IsPositive() {
+		dust.TrackDec(
+			ctx,
+			types.ModuleName,
+			dust.DestinationValidator,
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec(res.Amount.Denom, remainder)),
+		)
+	}
+
+	return res, nil
+}
+
+// UpdateParams defines a governance operation for updating the staking params.
+// While an emergency MaxValidators target is set, the MaxValidators param is
+// managed by the Evmos staking EndBlocker, so the method rejects any value other
+// than the current one or the target before relaying the message to the Cosmos
+// SDK staking method.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	params, err := k.GetValidatorSetParams(goCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.TargetMaxValidators != 0 && msg.Params.MaxValidators != params.TargetMaxValidators {
+		current, err := k.GetParams(goCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		if msg.Params.MaxValidators != current.MaxValidators {
+			return nil, errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest,
+				"max validators is moved towards the emergency target %d; expected %d or %d, got %d",
+				params.TargetMaxValidators, current.MaxValidators, params.TargetMaxValidators, msg.Params.MaxValidators,
+			)
+		}
+	}
+
+	return k.MsgServer.UpdateParams(goCtx, msg)
+}
+
+// UpdateValidatorSetParams defines a governance operation for updating the emergency
+// validator set params. The authority is hard-coded to the Cosmos SDK x/gov module account.
+func (k *Keeper) UpdateValidatorSetParams(
+	goCtx context.Context,
+	req *evmosstakingtypes.MsgUpdateValidatorSetParams,
+) (*evmosstakingtypes.MsgUpdateValidatorSetParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := k.SetValidatorSetParams(goCtx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &evmosstakingtypes.MsgUpdateValidatorSetParamsResponse{}, nil
+}
+
 // validateDelegationAmountNotUnvested checks if the delegator is a clawback vesting account.
 // In such case, checks that the provided delegation amount is available according
 // to the current vesting schedule (unvested coins cannot be delegated).
@@ -8,14 +8,17 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkstakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/evmos/evmos/v20/testutil"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	evmostypes "github.com/evmos/evmos/v20/types"
+	"github.com/evmos/evmos/v20/utils/dust"
 	"github.com/evmos/evmos/v20/x/staking/keeper"
 	vestingtypes "github.com/evmos/evmos/v20/x/vesting/types"
 	"github.com/stretchr/testify/require"
@@ -265,3 +268,203 @@ func TestMsgCreateValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUndelegateDust(t *testing.T) {
+	var (
+		ctx                   sdk.Context
+		nw                    *network.UnitTestNetwork
+		defaultDelCoin        = sdk.NewCoin(evmostypes.BaseDenom, math.NewInt(1e18))
+		delegatorAddr, _      = utiltx.NewAccAddressAndKey()
+		otherDelegatorAddr, _ = utiltx.NewAccAddressAndKey()
+	)
+
+	testCases := []struct {
+		name string
+		// slashed is the amount of tokens removed from the validator before the undelegation
+		slashed math.Int
+		expDust bool
+	}{
+		{
+			"no dust when the shares are backed 1:1 by tokens",
+			math.ZeroInt(),
+			false,
+		},
+		{
+			"dust is left to the validator when the undelegated tokens are truncated",
+			math.NewInt(1e17).QuoRaw(3),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext()
+			srv := keeper.NewMsgServerImpl(&nw.App.StakingKeeper)
+
+			valAddr, err := sdk.ValAddressFromBech32(nw.GetValidators()[0].OperatorAddress)
+			require.NoError(t, err)
+
+			for _, addr := range []sdk.AccAddress{delegatorAddr, otherDelegatorAddr} {
+				err := testutil.FundAccountWithBaseDenom(ctx, nw.App.BankKeeper, addr, defaultDelCoin.Amount.Int64())
+				require.NoError(t, err)
+				_, err = srv.Delegate(ctx, &types.MsgDelegate{
+					DelegatorAddress: addr.String(),
+					ValidatorAddress: valAddr.String(),
+					Amount:           defaultDelCoin,
+				})
+				require.NoError(t, err)
+			}
+
+			if tc.slashed.IsPositive() {
+				validator, err := nw.App.StakingKeeper.GetValidator(ctx, valAddr)
+				require.NoError(t, err)
+				_, err = nw.App.StakingKeeper.RemoveValidatorTokens(ctx, validator, tc.slashed)
+				require.NoError(t, err)
+			}
+
+			// token value of the remaining delegation before the undelegation
+			validator, err := nw.App.StakingKeeper.GetValidator(ctx, valAddr)
+			require.NoError(t, err)
+			otherDelegation, err := nw.App.StakingKeeper.GetDelegation(ctx, otherDelegatorAddr, valAddr)
+			require.NoError(t, err)
+			expOtherTokens := validator.TokensFromShares(otherDelegation.Shares)
+
+			msg := &types.MsgUndelegate{
+				DelegatorAddress: delegatorAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Amount:           sdk.NewCoin(evmostypes.BaseDenom, math.NewInt(1e17)),
+			}
+
+			// tracking the dust does not change the gas cost of the undelegation
+			sdkCtx, _ := ctx.CacheContext()
+			sdkCtx = sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			_, err = sdkstakingkeeper.NewMsgServerImpl(nw.App.StakingKeeper.Keeper).Undelegate(sdkCtx, msg)
+			require.NoError(t, err)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+			res, err := srv.Undelegate(ctx, msg)
+			require.NoError(t, err)
+			require.Equal(t, sdkCtx.GasMeter().GasConsumed(), ctx.GasMeter().GasConsumed())
+
+			// the backing tokens of the remaining delegators are not moved, so their
+			// token value can only grow by their part of the sub-unit dust
+			validator, err = nw.App.StakingKeeper.GetValidator(ctx, valAddr)
+			require.NoError(t, err)
+			otherTokens := validator.TokensFromShares(otherDelegation.Shares)
+			require.True(t, otherTokens.GTE(expOtherTokens), "expected %s >= %s", otherTokens, expOtherTokens)
+			require.True(t, otherTokens.Sub(expOtherTokens).LT(math.LegacyOneDec()))
+
+			var dustEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == dust.EventTypeDust {
+					dustEvents = append(dustEvents, event)
+				}
+			}
+			if !tc.expDust {
+				require.Empty(t, dustEvents)
+				return
+			}
+
+			require.Len(t, dustEvents, 1)
+			destination, ok := dustEvents[0].GetAttribute(dust.AttributeKeyDestination)
+			require.True(t, ok)
+			require.Equal(t, dust.DestinationValidator, destination.Value)
+			amount, ok := dustEvents[0].GetAttribute(sdk.AttributeKeyAmount)
+			require.True(t, ok)
+			dustCoins, err := sdk.ParseDecCoins(amount.Value)
+			require.NoError(t, err)
+			require.True(t, dustCoins.AmountOf(evmostypes.BaseDenom).LT(math.LegacyOneDec()))
+			require.True(t, dustCoins.AmountOf(evmostypes.BaseDenom).IsPositive())
+			require.True(t, res.Amount.Amount.LT(math.NewInt(1e17)))
+		})
+	}
+}
+
+func TestMsgUndelegateDustInvalidMsg(t *testing.T) {
+	var (
+		ctx              sdk.Context
+		nw               *network.UnitTestNetwork
+		defaultDelCoin   = sdk.NewCoin(evmostypes.BaseDenom, math.NewInt(1e18))
+		delegatorAddr, _ = utiltx.NewAccAddressAndKey()
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(valAddr sdk.ValAddress) *types.MsgUndelegate
+		errMsg   string
+	}{
+		{
+			"fail - invalid validator address",
+			func(sdk.ValAddress) *types.MsgUndelegate {
+				return &types.MsgUndelegate{
+					DelegatorAddress: delegatorAddr.String(),
+					ValidatorAddress: "invalid",
+					Amount:           sdk.NewCoin(evmostypes.BaseDenom, math.NewInt(1e17)),
+				}
+			},
+			"invalid validator address",
+		},
+		{
+			"fail - validator not found",
+			func(sdk.ValAddress) *types.MsgUndelegate {
+				return &types.MsgUndelegate{
+					DelegatorAddress: delegatorAddr.String(),
+					ValidatorAddress: sdk.ValAddress(utiltx.GenerateAddress().Bytes()).String(),
+					Amount:           sdk.NewCoin(evmostypes.BaseDenom, math.NewInt(1e17)),
+				}
+			},
+			types.ErrNoValidatorFound.Error(),
+		},
+		{
+			"fail - invalid delegator address",
+			func(valAddr sdk.ValAddress) *types.MsgUndelegate {
+				return &types.MsgUndelegate{
+					DelegatorAddress: "invalid",
+					ValidatorAddress: valAddr.String(),
+					Amount:           sdk.NewCoin(evmostypes.BaseDenom, math.NewInt(1e17)),
+				}
+			},
+			"invalid delegator address",
+		},
+		{
+			"fail - amount greater than the delegation",
+			func(valAddr sdk.ValAddress) *types.MsgUndelegate {
+				return &types.MsgUndelegate{
+					DelegatorAddress: delegatorAddr.String(),
+					ValidatorAddress: valAddr.String(),
+					Amount:           defaultDelCoin.AddAmount(math.OneInt()),
+				}
+			},
+			"invalid shares amount",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext()
+			srv := keeper.NewMsgServerImpl(&nw.App.StakingKeeper)
+
+			valAddr, err := sdk.ValAddressFromBech32(nw.GetValidators()[0].OperatorAddress)
+			require.NoError(t, err)
+
+			err = testutil.FundAccountWithBaseDenom(ctx, nw.App.BankKeeper, delegatorAddr, defaultDelCoin.Amount.Int64())
+			require.NoError(t, err)
+			_, err = srv.Delegate(ctx, &types.MsgDelegate{
+				DelegatorAddress: delegatorAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Amount:           defaultDelCoin,
+			})
+			require.NoError(t, err)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			_, err = srv.Undelegate(ctx, tc.malleate(valAddr))
+			require.ErrorContains(t, err, tc.errMsg)
+
+			for _, event := range ctx.EventManager().Events() {
+				require.NotEqual(t, dust.EventTypeDust, event.Type)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	evmosstakingtypes "github.com/evmos/evmos/v20/x/staking/types"
+)
+
+// GetValidatorSetParams returns the emergency validator set params. The default
+// params are returned if they were never set.
+func (k Keeper) GetValidatorSetParams(ctx context.Context) (evmosstakingtypes.ValidatorSetParams, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(evmosstakingtypes.ValidatorSetParamsKey)
+	if err != nil {
+		return evmosstakingtypes.ValidatorSetParams{}, err
+	}
+	if bz == nil {
+		return evmosstakingtypes.DefaultValidatorSetParams(), nil
+	}
+
+	var params evmosstakingtypes.ValidatorSetParams
+	if err := k.cdc.Unmarshal(bz, &params); err != nil {
+		return evmosstakingtypes.ValidatorSetParams{}, err
+	}
+	return params, nil
+}
+
+// SetValidatorSetParams validates and stores the emergency validator set params.
+func (k Keeper) SetValidatorSetParams(ctx context.Context, params evmosstakingtypes.ValidatorSetParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(evmosstakingtypes.ValidatorSetParamsKey, bz)
+}
+
+// EndBlocker moves the MaxValidators staking param towards the emergency target
+// before computing the validator set updates with the Cosmos SDK staking logic.
+// The consensus power of the resulting validator set is then capped according
+// to the emergency params. The stake above the cap remains delegated.
+//
+// NOTE: while a target is set, the staking MsgUpdateParams can't set a
+// MaxValidators value other than the current one or the target.
+func (k *Keeper) EndBlocker(ctx context.Context) ([]abci.ValidatorUpdate, error) {
+	params, err := k.GetValidatorSetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.adjustMaxValidators(ctx, params); err != nil {
+		return nil, err
+	}
+
+	updates, err := k.Keeper.EndBlocker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.capValidatorUpdates(ctx, params, updates)
+}
+
+// InitGenesis caps the consensus power of the validator set returned by the
+// Cosmos SDK staking genesis. The power sent for capped validators is not
+// exported, so it is computed again from the given params.
+func (k Keeper) InitGenesis(
+	ctx context.Context,
+	params evmosstakingtypes.ValidatorSetParams,
+	updates []abci.ValidatorUpdate,
+) ([]abci.ValidatorUpdate, error) {
+	if err := k.SetValidatorSetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return k.capValidatorUpdates(ctx, params, updates)
+}
+
+// SlashWithInfractionReason slashes the validator with the power it had at the
+// infraction height without the cap, as the power reported by CometBFT for
+// power-capped validators doesn't account for their entire stake. The reported
+// power is used if the historical info of the infraction height was pruned.
+func (k Keeper) SlashWithInfractionReason(
+	ctx context.Context,
+	consAddr sdk.ConsAddress,
+	infractionHeight, power int64,
+	slashFactor math.LegacyDec,
+	infraction types.Infraction,
+) (math.Int, error) {
+	historicalPower, err := k.getHistoricalPower(ctx, consAddr, infractionHeight)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	power = max(power, historicalPower)
+	return k.Keeper.SlashWithInfractionReason(ctx, consAddr, infractionHeight, power, slashFactor, infraction)
+}
+
+// getHistoricalPower returns the uncapped consensus power of the validator in the
+// validator set tracked at the given height. Zero is returned if the height is not
+// tracked or the validator wasn't part of the set.
+func (k Keeper) getHistoricalPower(ctx context.Context, consAddr sdk.ConsAddress, height int64) (int64, error) {
+	hi, err := k.GetHistoricalInfo(ctx, height)
+	if errors.Is(err, types.ErrNoHistoricalInfo) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	powerReduction := k.PowerReduction(ctx)
+	for _, validator := range hi.Valset {
+		bz, err := validator.GetConsAddr()
+		if err != nil {
+			return 0, err
+		}
+		if consAddr.Equals(sdk.ConsAddress(bz)) {
+			return validator.ConsensusPower(powerReduction), nil
+		}
+	}
+
+	return 0, nil
+}
+
+// adjustMaxValidators updates the MaxValidators staking param with the next
+// value towards the emergency target, if any, and emits an event on change.
+func (k Keeper) adjustMaxValidators(ctx context.Context, params evmosstakingtypes.ValidatorSetParams) error {
+	stakingParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	maxValidators := params.NextMaxValidators(stakingParams.MaxValidators)
+	if maxValidators == stakingParams.MaxValidators {
+		return nil
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			evmosstakingtypes.EventTypeAdjustMaxValidators,
+			sdk.NewAttribute(evmosstakingtypes.AttributeKeyPreviousMaxValidators, strconv.FormatUint(uint64(stakingParams.MaxValidators), 10)),
+			sdk.NewAttribute(evmosstakingtypes.AttributeKeyMaxValidators, strconv.FormatUint(uint64(maxValidators), 10)),
+			sdk.NewAttribute(evmosstakingtypes.AttributeKeyTargetMaxValidators, strconv.FormatUint(uint64(params.TargetMaxValidators), 10)),
+		),
+	)
+
+	stakingParams.MaxValidators = maxValidators
+	return k.SetParams(ctx, stakingParams)
+}
+
+// capValidatorUpdates returns the validator updates to send to CometBFT with the
+// capped consensus power of the bonded validators. The power sent for the
+// validators whose power is capped is stored, so that updates are only sent when
+// the capped power changes. Validators without a stored power are expected to
+// have the power computed by the Cosmos SDK staking logic.
+func (k Keeper) capValidatorUpdates(
+	ctx context.Context,
+	params evmosstakingtypes.ValidatorSetParams,
+	sdkUpdates []abci.ValidatorUpdate,
+) ([]abci.ValidatorUpdate, error) {
+	validators, err := k.GetLastValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	powerReduction := k.PowerReduction(ctx)
+	powers := make([]int64, len(validators))
+	for i, validator := range validators {
+		powers[i] = validator.ConsensusPower(powerReduction)
+	}
+	capped := evmosstakingtypes.CapPowers(powers, params.MaxPowerPercent)
+	if params.IsPowerCapEnabled() && !evmosstakingtypes.IsPowerCapAchievable(params.MaxPowerPercent, len(powers)) {
+		k.Logger(ctx).Error(
+			"not enough bonded validators to honor the power cap, all validators get the same power",
+			"max_power_percent", params.MaxPowerPercent.String(),
+			"bonded_validators", len(powers),
+		)
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				evmosstakingtypes.EventTypePowerCapNotHonored,
+				sdk.NewAttribute(evmosstakingtypes.AttributeKeyMaxPowerPercent, params.MaxPowerPercent.String()),
+				sdk.NewAttribute(evmosstakingtypes.AttributeKeyBondedValidators, strconv.Itoa(len(powers))),
+			),
+		)
+	}
+
+	updates := make([]abci.ValidatorUpdate, 0, len(sdkUpdates))
+	updated := make(map[string]bool, len(sdkUpdates))
+	for _, update := range sdkUpdates {
+		pk, err := cryptocodec.FromCmtProtoPublicKey(update.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		consAddr := sdk.ConsAddress(pk.Address())
+		updated[consAddr.String()] = true
+
+		// validators removed from the set have no power to cap
+		if update.Power == 0 {
+			if err := k.deleteCappedPower(ctx, consAddr); err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		}
+	}
+
+	for i, validator := range validators {
+		bz, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, err
+		}
+		consAddr := sdk.ConsAddress(bz)
+
+		prevPower, found, err := k.getCappedPower(ctx, consAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		if capped[i] != powers[i] {
+			err = k.setCappedPower(ctx, consAddr, capped[i])
+		} else if found {
+			err = k.deleteCappedPower(ctx, consAddr)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// skip if CometBFT already has the capped power or gets it from the
+		// Cosmos SDK update
+		if found && prevPower == capped[i] {
+			continue
+		}
+		if !found && capped[i] == powers[i] && !updated[consAddr.String()] {
+			continue
+		}
+
+		pk, err := validator.CmtConsPublicKey()
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, abci.ValidatorUpdate{PubKey: pk, Power: capped[i]})
+	}
+
+	if err := k.SetValidatorUpdates(ctx, updates); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
+
+// getCappedPower returns the consensus power sent to CometBFT for a power-capped validator.
+func (k Keeper) getCappedPower(ctx context.Context, consAddr sdk.ConsAddress) (int64, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(evmosstakingtypes.GetCappedPowerKey(consAddr))
+	if err != nil || bz == nil {
+		return 0, false, err
+	}
+
+	var power gogotypes.Int64Value
+	if err := k.cdc.Unmarshal(bz, &power); err != nil {
+		return 0, false, err
+	}
+	return power.GetValue(), true, nil
+}
+
+// setCappedPower stores the consensus power sent to CometBFT for a power-capped validator.
+func (k Keeper) setCappedPower(ctx context.Context, consAddr sdk.ConsAddress, power int64) error {
+	bz, err := k.cdc.Marshal(&gogotypes.Int64Value{Value: power})
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(evmosstakingtypes.GetCappedPowerKey(consAddr), bz)
+}
+
+// deleteCappedPower removes the stored consensus power of a validator.
+func (k Keeper) deleteCappedPower(ctx context.Context, consAddr sdk.ConsAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(evmosstakingtypes.GetCappedPowerKey(consAddr))
+}
@@ -0,0 +1,410 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/testutil"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/staking/keeper"
+	evmosstakingtypes "github.com/evmos/evmos/v20/x/staking/types"
+)
+
+// capParams caps the consensus power of a single validator to half of the total power.
+var capParams = evmosstakingtypes.NewValidatorSetParams(math.LegacyNewDecWithPrec(5, 1), 0, 0)
+
+// delegate adds the given consensus power to the validator with a new delegator.
+func delegate(t *testing.T, ctx sdk.Context, nw *network.UnitTestNetwork, valAddr sdk.ValAddress, power int64) {
+	delAddr, _ := utiltx.NewAccAddressAndKey()
+	amount := sdk.TokensFromConsensusPower(power, nw.App.StakingKeeper.PowerReduction(ctx))
+	err := testutil.FundAccountWithBaseDenom(ctx, nw.App.BankKeeper, delAddr, amount.Int64())
+	require.NoError(t, err)
+
+	validator, err := nw.App.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = nw.App.StakingKeeper.Delegate(ctx, delAddr, amount, types.Unbonded, validator, true)
+	require.NoError(t, err)
+}
+
+// updatedPower returns the power of the validator in the given updates, if any.
+func updatedPower(t *testing.T, ctx sdk.Context, nw *network.UnitTestNetwork, updates []abci.ValidatorUpdate, valAddr sdk.ValAddress) (int64, bool) {
+	validator, err := nw.App.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	pk, err := validator.CmtConsPublicKey()
+	require.NoError(t, err)
+
+	for _, update := range updates {
+		if update.PubKey.Equal(pk) {
+			return update.Power, true
+		}
+	}
+	return 0, false
+}
+
+func TestEndBlockerPowerCap(t *testing.T) {
+	var (
+		ctx     sdk.Context
+		nw      *network.UnitTestNetwork
+		valAddr sdk.ValAddress
+	)
+
+	testCases := []struct {
+		name string
+		// malleate runs after the power of the first validator was capped
+		malleate   func()
+		expUpdates int
+		expPower   int64
+	}{
+		{
+			"no update while the capped power is unchanged",
+			func() {
+				delegate(t, ctx, nw, valAddr, 1)
+			},
+			0,
+			0,
+		},
+		{
+			"update with the uncapped power when the cap is removed",
+			func() {
+				err := nw.App.StakingKeeper.SetValidatorSetParams(ctx, evmosstakingtypes.DefaultValidatorSetParams())
+				require.NoError(t, err)
+			},
+			1,
+			10,
+		},
+		{
+			"update with the new capped power when the other validators change",
+			func() {
+				delegate(t, ctx, nw, mustValAddr(t, nw, 1), 2)
+			},
+			2,
+			4,
+		},
+		{
+			"zero power update when the capped validator is removed from the set",
+			func() {
+				validator, err := nw.App.StakingKeeper.GetValidator(ctx, valAddr)
+				require.NoError(t, err)
+				consAddr, err := validator.GetConsAddr()
+				require.NoError(t, err)
+				require.NoError(t, nw.App.StakingKeeper.Jail(ctx, consAddr))
+			},
+			1,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext()
+			valAddr = mustValAddr(t, nw, 0)
+
+			// the first validator holds 10 of the 12 consensus power
+			require.NoError(t, nw.App.StakingKeeper.SetValidatorSetParams(ctx, capParams))
+			delegate(t, ctx, nw, valAddr, 9)
+
+			updates, err := nw.App.StakingKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+			require.Len(t, updates, 1)
+			power, found := updatedPower(t, ctx, nw, updates, valAddr)
+			require.True(t, found)
+			require.Equal(t, int64(2), power)
+
+			tc.malleate()
+
+			updates, err = nw.App.StakingKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+			require.Len(t, updates, tc.expUpdates)
+			if tc.expUpdates == 0 {
+				return
+			}
+
+			power, found = updatedPower(t, ctx, nw, updates, valAddr)
+			require.True(t, found)
+			require.Equal(t, tc.expPower, power)
+		})
+	}
+}
+
+func TestEndBlockerPowerCapNotAchievable(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext().WithEventManager(sdk.NewEventManager())
+	valAddr := mustValAddr(t, nw, 0)
+
+	validators, err := nw.App.StakingKeeper.GetLastValidators(ctx)
+	require.NoError(t, err)
+
+	// a 5% cap needs at least 20 validators
+	params := evmosstakingtypes.NewValidatorSetParams(math.LegacyNewDecWithPrec(5, 2), 0, 0)
+	require.Less(t, len(validators), 20)
+	require.NoError(t, nw.App.StakingKeeper.SetValidatorSetParams(ctx, params))
+	delegate(t, ctx, nw, valAddr, 9)
+
+	updates, err := nw.App.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	// the tightest achievable cap is used, so all validators get the same power
+	require.Len(t, updates, 1)
+	power, found := updatedPower(t, ctx, nw, updates, valAddr)
+	require.True(t, found)
+	require.Equal(t, int64(1), power)
+
+	var emitted bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != evmosstakingtypes.EventTypePowerCapNotHonored {
+			continue
+		}
+		emitted = true
+
+		attr, ok := event.GetAttribute(evmosstakingtypes.AttributeKeyMaxPowerPercent)
+		require.True(t, ok)
+		require.Equal(t, params.MaxPowerPercent.String(), attr.Value)
+		attr, ok = event.GetAttribute(evmosstakingtypes.AttributeKeyBondedValidators)
+		require.True(t, ok)
+		require.Equal(t, strconv.Itoa(len(validators)), attr.Value)
+	}
+	require.True(t, emitted)
+}
+
+func TestEndBlockerAdjustMaxValidators(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext().WithEventManager(sdk.NewEventManager())
+
+	stakingParams, err := nw.App.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	initial := stakingParams.MaxValidators
+
+	params := evmosstakingtypes.NewValidatorSetParams(math.LegacyZeroDec(), initial-3, 2)
+	require.NoError(t, nw.App.StakingKeeper.SetValidatorSetParams(ctx, params))
+
+	for _, expMaxValidators := range []uint32{initial - 2, initial - 3, initial - 3} {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err := nw.App.StakingKeeper.EndBlocker(ctx)
+		require.NoError(t, err)
+
+		stakingParams, err := nw.App.StakingKeeper.GetParams(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expMaxValidators, stakingParams.MaxValidators)
+
+		var events []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == evmosstakingtypes.EventTypeAdjustMaxValidators {
+				events = append(events, event)
+			}
+		}
+
+		// no event is emitted once the target is reached
+		if expMaxValidators == initial-3 && len(events) == 0 {
+			continue
+		}
+		require.Len(t, events, 1)
+		attr, ok := events[0].GetAttribute(evmosstakingtypes.AttributeKeyMaxValidators)
+		require.True(t, ok)
+		require.Equal(t, strconv.FormatUint(uint64(expMaxValidators), 10), attr.Value)
+	}
+}
+
+func TestSlashWithInfractionReason(t *testing.T) {
+	testCases := []struct {
+		name string
+		// pruneHistory removes the historical info of the infraction height
+		pruneHistory bool
+		expSlashed   math.Int
+	}{
+		{
+			"slash with the uncapped historical power",
+			false,
+			math.NewInt(1e18),
+		},
+		{
+			"slash with the reported power without historical info",
+			true,
+			math.NewInt(2e17),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+			valAddr := mustValAddr(t, nw, 0)
+
+			require.NoError(t, nw.App.StakingKeeper.SetValidatorSetParams(ctx, capParams))
+			delegate(t, ctx, nw, valAddr, 9)
+			_, err := nw.App.StakingKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			require.NoError(t, nw.App.StakingKeeper.TrackHistoricalInfo(ctx))
+			if tc.pruneHistory {
+				require.NoError(t, nw.App.StakingKeeper.DeleteHistoricalInfo(ctx, ctx.BlockHeight()))
+			}
+
+			validator, err := nw.App.StakingKeeper.GetValidator(ctx, valAddr)
+			require.NoError(t, err)
+			consAddr, err := validator.GetConsAddr()
+			require.NoError(t, err)
+
+			// CometBFT reports the capped power of the validator
+			slashed, err := nw.App.StakingKeeper.SlashWithInfractionReason(
+				ctx, consAddr, ctx.BlockHeight(), 2, math.LegacyNewDecWithPrec(1, 1), types.Infraction_INFRACTION_DOUBLE_SIGN,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expSlashed.String(), slashed.String())
+		})
+	}
+}
+
+func TestInitGenesisPowerCap(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	valAddr := mustValAddr(t, nw, 0)
+
+	// the validator set is updated without the cap
+	delegate(t, ctx, nw, valAddr, 9)
+	_, err := nw.App.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	// the Cosmos SDK staking genesis returns the uncapped power of the whole set
+	validators, err := nw.App.StakingKeeper.GetLastValidators(ctx)
+	require.NoError(t, err)
+	sdkUpdates := make([]abci.ValidatorUpdate, len(validators))
+	for i, validator := range validators {
+		sdkUpdates[i] = validator.ABCIValidatorUpdate(nw.App.StakingKeeper.PowerReduction(ctx))
+	}
+
+	updates, err := nw.App.StakingKeeper.InitGenesis(ctx, capParams, sdkUpdates)
+	require.NoError(t, err)
+	require.Len(t, updates, 3)
+	power, found := updatedPower(t, ctx, nw, updates, valAddr)
+	require.True(t, found)
+	require.Equal(t, int64(2), power)
+
+	params, err := nw.App.StakingKeeper.GetValidatorSetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, capParams, params)
+}
+
+func TestMsgUpdateValidatorSetParams(t *testing.T) {
+	testCases := []struct {
+		name      string
+		authority string
+		params    evmosstakingtypes.ValidatorSetParams
+		expErr    bool
+		errMsg    string
+	}{
+		{
+			"fail - invalid authority",
+			"invalid",
+			capParams,
+			true,
+			"invalid authority",
+		},
+		{
+			"fail - invalid params",
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			evmosstakingtypes.NewValidatorSetParams(math.LegacyNewDec(2), 0, 0),
+			true,
+			"max power percent",
+		},
+		{
+			"pass - valid params",
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			capParams,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+
+			_, err := nw.App.StakingKeeper.UpdateValidatorSetParams(ctx, &evmosstakingtypes.MsgUpdateValidatorSetParams{
+				Authority: tc.authority,
+				Params:    tc.params,
+			})
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			params, err := nw.App.StakingKeeper.GetValidatorSetParams(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.params, params)
+		})
+	}
+}
+
+func TestMsgUpdateParamsWithTarget(t *testing.T) {
+	testCases := []struct {
+		name string
+		// maxValidators returns the MaxValidators value of the update given the current one
+		maxValidators func(current uint32) uint32
+		expErr        bool
+	}{
+		{
+			"pass - current value",
+			func(current uint32) uint32 { return current },
+			false,
+		},
+		{
+			"pass - target value",
+			func(current uint32) uint32 { return current - 10 },
+			false,
+		},
+		{
+			"fail - conflicting value",
+			func(current uint32) uint32 { return current + 1 },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+
+			stakingParams, err := nw.App.StakingKeeper.GetParams(ctx)
+			require.NoError(t, err)
+			params := evmosstakingtypes.NewValidatorSetParams(math.LegacyZeroDec(), stakingParams.MaxValidators-10, 1)
+			require.NoError(t, nw.App.StakingKeeper.SetValidatorSetParams(ctx, params))
+
+			stakingParams.MaxValidators = tc.maxValidators(stakingParams.MaxValidators)
+			srv := keeper.NewMsgServerImpl(&nw.App.StakingKeeper)
+			_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    stakingParams,
+			})
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "emergency target")
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+// mustValAddr returns the operator address of the i-th validator of the network.
+func mustValAddr(t *testing.T, nw *network.UnitTestNetwork, i int) sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(nw.GetValidators()[i].OperatorAddress)
+	require.NoError(t, err)
+	return valAddr
+}
@@ -4,11 +4,17 @@
 package staking
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 
 	"cosmossdk.io/core/appmodule"
+	abci "github.com/cometbft/cometbft/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -16,8 +22,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/evmos/evmos/v20/x/staking/keeper"
+	evmosstakingtypes "github.com/evmos/evmos/v20/x/staking/types"
 )
 
+// validatorSetParamsKey is the optional field of the staking genesis state that
+// holds the emergency validator set params. It is only exported while the params
+// are enabled, so that the genesis state remains compatible with the Cosmos SDK
+// staking genesis state otherwise.
+const validatorSetParamsKey = "validator_set_params"
+
 var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
@@ -35,6 +48,35 @@ type AppModuleBasic struct {
 	*staking.AppModuleBasic
 }
 
+// RegisterLegacyAminoCodec registers the Cosmos SDK staking and the Evmos staking
+// wrapper types on the LegacyAmino codec.
+func (amb AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	amb.AppModuleBasic.RegisterLegacyAminoCodec(cdc)
+	evmosstakingtypes.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers the Cosmos SDK staking and the Evmos staking
+// wrapper interface types.
+func (amb AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	amb.AppModuleBasic.RegisterInterfaces(registry)
+	evmosstakingtypes.RegisterInterfaces(registry)
+}
+
+// ValidateGenesis performs genesis state validation for the staking module,
+// including the optional emergency validator set params.
+func (amb AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	sdkGenesis, params, err := splitGenesis(cdc, bz)
+	if err != nil {
+		return err
+	}
+
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid %s: %w", validatorSetParamsKey, err)
+	}
+
+	return amb.AppModuleBasic.ValidateGenesis(cdc, config, sdkGenesis)
+}
+
 // AppModule represents a wrapper around the Cosmos SDK staking module AppModule and
 // the Evmos custom staking module keeper.
 type AppModule struct {
@@ -63,6 +105,7 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// Override Staking Msg Server
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	evmosstakingtypes.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	querier := stakingkeeper.Querier{Keeper: am.keeper.Keeper}
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
 
@@ -83,3 +126,82 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
 	}
 }
+
+// EndBlock returns the validator set updates computed by the Evmos staking keeper,
+// which enforces the emergency validator set params on top of the Cosmos SDK logic.
+func (am AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
+	return am.keeper.EndBlocker(ctx)
+}
+
+// InitGenesis performs the Cosmos SDK staking genesis initialization and stores
+// the emergency validator set params. The returned validator set is capped
+// according to the params.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	sdkGenesis, params, err := splitGenesis(cdc, data)
+	if err != nil {
+		panic(err)
+	}
+
+	updates := am.AppModule.InitGenesis(ctx, cdc, sdkGenesis)
+	updates, err = am.keeper.InitGenesis(ctx, params, updates)
+	if err != nil {
+		panic(err)
+	}
+
+	return updates
+}
+
+// ExportGenesis returns the Cosmos SDK staking genesis state with the emergency
+// validator set params, if they are enabled.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	bz := am.AppModule.ExportGenesis(ctx, cdc)
+
+	params, err := am.keeper.GetValidatorSetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if !params.IsEnabled() {
+		return bz
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		panic(err)
+	}
+	fields[validatorSetParamsKey] = cdc.MustMarshalJSON(&params)
+
+	bz, err = json.Marshal(fields)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// splitGenesis returns the Cosmos SDK staking genesis state and the emergency
+// validator set params from the given staking genesis state. The default params
+// are returned if the genesis state doesn't include them.
+func splitGenesis(cdc codec.JSONCodec, bz json.RawMessage) (json.RawMessage, evmosstakingtypes.ValidatorSetParams, error) {
+	params := evmosstakingtypes.DefaultValidatorSetParams()
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, params, fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	paramsBz, found := fields[validatorSetParamsKey]
+	if !found {
+		return bz, params, nil
+	}
+
+	if err := cdc.UnmarshalJSON(paramsBz, &params); err != nil {
+		return nil, params, fmt.Errorf("failed to unmarshal %s: %w", validatorSetParamsKey, err)
+	}
+
+	delete(fields, validatorSetParamsKey)
+	sdkGenesis, err := json.Marshal(fields)
+	if err != nil {
+		return nil, params, err
+	}
+
+	return sdkGenesis, params, nil
+}
@@ -0,0 +1,16 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// Evmos staking wrapper events
+const (
+	EventTypeAdjustMaxValidators = "adjust_max_validators"
+	EventTypePowerCapNotHonored  = "power_cap_not_honored"
+
+	AttributeKeyPreviousMaxValidators = "previous_max_validators"
+	AttributeKeyMaxValidators         = "max_validators"
+	AttributeKeyTargetMaxValidators   = "target_max_validators"
+	AttributeKeyMaxPowerPercent       = "max_power_percent"
+	AttributeKeyBondedValidators      = "bonded_validators"
+)
@@ -0,0 +1,25 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// prefix bytes for the Evmos staking wrapper entries in the staking store. They
+// are chosen to not collide with the prefixes used by the Cosmos SDK staking module.
+const (
+	prefixValidatorSetParams = iota + 0xA1
+	prefixCappedPower
+)
+
+// KVStore key prefixes
+var (
+	ValidatorSetParamsKey = []byte{prefixValidatorSetParams}
+	KeyPrefixCappedPower  = []byte{prefixCappedPower}
+)
+
+// GetCappedPowerKey returns the key of the consensus power sent to CometBFT for
+// a power-capped validator.
+func GetCappedPowerKey(consAddr sdk.ConsAddress) []byte {
+	return append(KeyPrefixCappedPower, consAddr.Bytes()...)
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateValidatorSetParamsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msgUpdate *MsgUpdateValidatorSetParams
+		expPass   bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgUpdateValidatorSetParams{
+				Authority: "invalid",
+				Params:    DefaultValidatorSetParams(),
+			},
+			false,
+		},
+		{
+			"fail - invalid params",
+			&MsgUpdateValidatorSetParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    NewValidatorSetParams(math.LegacyNewDec(2), 0, 0),
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgUpdateValidatorSetParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    DefaultValidatorSetParams(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msgUpdate.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewValidatorSetParams creates a new ValidatorSetParams instance.
+func NewValidatorSetParams(
+	maxPowerPercent math.LegacyDec,
+	targetMaxValidators uint32,
+	maxValidatorsStep uint32,
+) ValidatorSetParams {
+	return ValidatorSetParams{
+		MaxPowerPercent:     maxPowerPercent,
+		TargetMaxValidators: targetMaxValidators,
+		MaxValidatorsStep:   maxValidatorsStep,
+	}
+}
+
+// DefaultValidatorSetParams returns the default validator set params, which
+// leave the Cosmos SDK staking behavior unchanged.
+func DefaultValidatorSetParams() ValidatorSetParams {
+	return ValidatorSetParams{
+		MaxPowerPercent:     math.LegacyZeroDec(),
+		TargetMaxValidators: 0,
+		MaxValidatorsStep:   0,
+	}
+}
+
+// Validate performs a basic validation of the validator set params.
+func (p ValidatorSetParams) Validate() error {
+	if p.MaxPowerPercent.IsNil() {
+		return fmt.Errorf("max power percent cannot be nil")
+	}
+
+	if p.MaxPowerPercent.IsNegative() || p.MaxPowerPercent.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max power percent must be between 0 and 1: %s", p.MaxPowerPercent)
+	}
+
+	return nil
+}
+
+// IsPowerCapEnabled returns true if a per-validator power cap is set.
+func (p ValidatorSetParams) IsPowerCapEnabled() bool {
+	return p.MaxPowerPercent.IsPositive() && p.MaxPowerPercent.LT(math.LegacyOneDec())
+}
+
+// IsEnabled returns true if the params change the Cosmos SDK staking behavior.
+func (p ValidatorSetParams) IsEnabled() bool {
+	return p.IsPowerCapEnabled() || p.TargetMaxValidators != 0
+}
+
+// NextMaxValidators returns the MaxValidators value for the next block, moving the
+// current value towards the target by at most MaxValidatorsStep.
+func (p ValidatorSetParams) NextMaxValidators(current uint32) uint32 {
+	if p.TargetMaxValidators == 0 || p.TargetMaxValidators == current {
+		return current
+	}
+
+	if p.MaxValidatorsStep == 0 {
+		return p.TargetMaxValidators
+	}
+
+	if p.TargetMaxValidators > current {
+		if p.TargetMaxValidators-current <= p.MaxValidatorsStep {
+			return p.TargetMaxValidators
+		}
+		return current + p.MaxValidatorsStep
+	}
+
+	if current-p.TargetMaxValidators <= p.MaxValidatorsStep {
+		return p.TargetMaxValidators
+	}
+	return current - p.MaxValidatorsStep
+}
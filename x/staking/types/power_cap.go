@@ -0,0 +1,73 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"slices"
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+// CapPowers returns the given consensus powers capped so that no validator holds
+// more than maxPowerPercent of the total capped power. The cap is the largest
+// value that satisfies the constraint, so the powers below it are unchanged.
+// The powers are returned unchanged if the cap is disabled. If the cap can't be
+// honored with the given amount of validators, the tightest achievable cap is
+// used instead, which gives all the validators the lowest of the powers.
+func CapPowers(powers []int64, maxPowerPercent math.LegacyDec) []int64 {
+	capped := make([]int64, len(powers))
+	copy(capped, powers)
+
+	if len(powers) == 0 || !maxPowerPercent.IsPositive() || maxPowerPercent.GTE(math.LegacyOneDec()) {
+		return capped
+	}
+
+	if !IsPowerCapAchievable(maxPowerPercent, len(powers)) {
+		minPower := slices.Min(powers)
+		for i := range capped {
+			capped[i] = minPower
+		}
+		return capped
+	}
+
+	order := make([]int, len(powers))
+	rest := math.ZeroInt()
+	for i, power := range powers {
+		order[i] = i
+		rest = rest.AddRaw(power)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return powers[order[i]] > powers[order[j]]
+	})
+
+	// Assuming the k largest powers are capped, the cap c solves
+	// c = maxPowerPercent * (rest + k*c), where rest is the sum of the uncapped
+	// powers. The first k for which the next largest power fits under c is the
+	// solution.
+	for k, i := range order {
+		remaining := math.LegacyOneDec().Sub(maxPowerPercent.MulInt64(int64(k)))
+		if !remaining.IsPositive() {
+			break
+		}
+
+		limit := maxPowerPercent.MulInt(rest).Quo(remaining).TruncateInt64()
+		if powers[i] <= limit {
+			for _, j := range order[:k] {
+				capped[j] = limit
+			}
+			return capped
+		}
+
+		rest = rest.SubRaw(powers[i])
+	}
+
+	return capped
+}
+
+// IsPowerCapAchievable returns true if the given amount of validators is enough
+// to honor the power cap, which requires at least 1/maxPowerPercent validators.
+func IsPowerCapAchievable(maxPowerPercent math.LegacyDec, numValidators int) bool {
+	return maxPowerPercent.MulInt64(int64(numValidators)).GTE(math.LegacyOneDec())
+}
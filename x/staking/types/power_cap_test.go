@@ -0,0 +1,126 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapPowers(t *testing.T) {
+	testCases := []struct {
+		name            string
+		powers          []int64
+		maxPowerPercent math.LegacyDec
+		expPowers       []int64
+	}{
+		{
+			"cap disabled",
+			[]int64{100, 1, 1},
+			math.LegacyZeroDec(),
+			[]int64{100, 1, 1},
+		},
+		{
+			"cap of 100%",
+			[]int64{100, 1, 1},
+			math.LegacyOneDec(),
+			[]int64{100, 1, 1},
+		},
+		{
+			"not enough validators to honor the cap",
+			[]int64{100, 1},
+			math.LegacyNewDecWithPrec(25, 2),
+			[]int64{1, 1},
+		},
+		{
+			"not enough validators with a rounded cap",
+			[]int64{100, 50, 7},
+			math.LegacyOneDec().QuoInt64(3),
+			[]int64{7, 7, 7},
+		},
+		{
+			"cap exactly achievable",
+			[]int64{100, 5, 5, 5},
+			math.LegacyNewDecWithPrec(25, 2),
+			[]int64{5, 5, 5, 5},
+		},
+		{
+			"no validator above the cap",
+			[]int64{100, 100, 1, 1},
+			math.LegacyNewDecWithPrec(5, 1),
+			[]int64{100, 100, 1, 1},
+		},
+		{
+			"single validator capped",
+			[]int64{1, 100, 1, 1},
+			math.LegacyNewDecWithPrec(5, 1),
+			[]int64{1, 3, 1, 1},
+		},
+		{
+			"multiple validators capped",
+			[]int64{100, 50, 10, 10, 10, 10, 10},
+			math.LegacyNewDecWithPrec(25, 2),
+			[]int64{25, 25, 10, 10, 10, 10, 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			capped := CapPowers(tc.powers, tc.maxPowerPercent)
+			require.Equal(t, tc.expPowers, capped)
+		})
+	}
+}
+
+func TestNextMaxValidators(t *testing.T) {
+	testCases := []struct {
+		name       string
+		params     ValidatorSetParams
+		current    uint32
+		expCurrent uint32
+	}{
+		{"no target", DefaultValidatorSetParams(), 100, 100},
+		{"target reached", NewValidatorSetParams(math.LegacyZeroDec(), 100, 5), 100, 100},
+		{"no step", NewValidatorSetParams(math.LegacyZeroDec(), 50, 0), 100, 50},
+		{"step down", NewValidatorSetParams(math.LegacyZeroDec(), 50, 5), 100, 95},
+		{"step up", NewValidatorSetParams(math.LegacyZeroDec(), 150, 5), 100, 105},
+		{"step over the target", NewValidatorSetParams(math.LegacyZeroDec(), 98, 5), 100, 98},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expCurrent, tc.params.NextMaxValidators(tc.current))
+		})
+	}
+}
+
+func TestValidatorSetParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  ValidatorSetParams
+		expPass bool
+	}{
+		{"default", DefaultValidatorSetParams(), true},
+		{"valid cap", NewValidatorSetParams(math.LegacyNewDecWithPrec(1, 1), 0, 0), true},
+		{"nil cap", ValidatorSetParams{}, false},
+		{"negative cap", NewValidatorSetParams(math.LegacyNewDec(-1), 0, 0), false},
+		{"cap above 100%", NewValidatorSetParams(math.LegacyNewDec(2), 0, 0), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestIsPowerCapAchievable(t *testing.T) {
+	require.False(t, IsPowerCapAchievable(math.LegacyNewDecWithPrec(5, 2), 15))
+	require.True(t, IsPowerCapAchievable(math.LegacyNewDecWithPrec(5, 2), 20))
+	require.True(t, IsPowerCapAchievable(math.LegacyNewDecWithPrec(5, 2), 21))
+}
@@ -0,0 +1,404 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/staking/v1/staking.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorSetParams defines the emergency parameters of the validator set
+// enforced by the staking wrapper on top of the Cosmos SDK staking params.
+type ValidatorSetParams struct {
+	// max_power_percent is the maximum share of the total consensus power that a
+	// single validator can hold. Stake above the cap remains delegated, but
+	// doesn't add consensus power. A zero value disables the cap. If there are
+	// fewer than 1/max_power_percent bonded validators, the cap can't be honored
+	// and all the validators get the same power instead.
+	MaxPowerPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=max_power_percent,json=maxPowerPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_power_percent"`
+	// target_max_validators is the maximum number of validators the staking
+	// MaxValidators param is moved towards. A zero value disables the adjustment.
+	TargetMaxValidators uint32 `protobuf:"varint,2,opt,name=target_max_validators,json=targetMaxValidators,proto3" json:"target_max_validators,omitempty"`
+	// max_validators_step is the maximum change of the staking MaxValidators param
+	// per block while moving towards the target. A zero value applies the target
+	// at once.
+	MaxValidatorsStep uint32 `protobuf:"varint,3,opt,name=max_validators_step,json=maxValidatorsStep,proto3" json:"max_validators_step,omitempty"`
+}
+
+func (m *ValidatorSetParams) Reset()         { *m = ValidatorSetParams{} }
+func (m *ValidatorSetParams) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetParams) ProtoMessage()    {}
+func (*ValidatorSetParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3abc2bfab8adbdd6, []int{0}
+}
+func (m *ValidatorSetParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetParams.Merge(m, src)
+}
+func (m *ValidatorSetParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetParams proto.InternalMessageInfo
+
+func (m *ValidatorSetParams) GetTargetMaxValidators() uint32 {
+	if m != nil {
+		return m.TargetMaxValidators
+	}
+	return 0
+}
+
+func (m *ValidatorSetParams) GetMaxValidatorsStep() uint32 {
+	if m != nil {
+		return m.MaxValidatorsStep
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValidatorSetParams)(nil), "evmos.staking.v1.ValidatorSetParams")
+}
+
+func init() { proto.RegisterFile("evmos/staking/v1/staking.proto", fileDescriptor_3abc2bfab8adbdd6) }
+
+var fileDescriptor_3abc2bfab8adbdd6 = []byte{
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x50, 0x41, 0x4b, 0x02, 0x41,
+	0x18, 0xdd, 0x29, 0x08, 0x5a, 0x88, 0x72, 0x2d, 0x10, 0x83, 0x51, 0x3a, 0x89, 0x87, 0x9d, 0xb4,
+	0x7f, 0x20, 0x1e, 0x0b, 0x44, 0xa3, 0x43, 0x97, 0xe5, 0x73, 0x1d, 0xd6, 0xc5, 0xc6, 0x6f, 0x98,
+	0xf9, 0xda, 0xd6, 0x7f, 0xd1, 0xcf, 0xe8, 0xd8, 0xcf, 0xf0, 0x14, 0x1e, 0xa3, 0x83, 0x84, 0x1e,
+	0xfa, 0x1b, 0xe1, 0x0e, 0x2b, 0x75, 0x79, 0x3c, 0xde, 0x7b, 0xf3, 0xe6, 0xe3, 0xf9, 0x5c, 0x66,
+	0x0a, 0xad, 0xb0, 0x04, 0xb3, 0x74, 0x9e, 0x88, 0xac, 0x53, 0xd2, 0x50, 0x1b, 0x24, 0x0c, 0xce,
+	0x0a, 0x3f, 0x2c, 0xc5, 0xac, 0x53, 0xaf, 0x80, 0x4a, 0xe7, 0x28, 0x0a, 0x74, 0xa1, 0xfa, 0x79,
+	0x82, 0x09, 0x16, 0x54, 0xec, 0x98, 0x53, 0xaf, 0x3e, 0x98, 0x1f, 0x3c, 0xc0, 0x53, 0x3a, 0x01,
+	0x42, 0x33, 0x92, 0x34, 0x00, 0x03, 0xca, 0x06, 0xf7, 0x7e, 0x45, 0x41, 0x1e, 0x69, 0x7c, 0x91,
+	0x26, 0xd2, 0xd2, 0xc4, 0x72, 0x4e, 0x35, 0xd6, 0x64, 0xad, 0xe3, 0x5e, 0x6b, 0xb9, 0x6e, 0x78,
+	0x5f, 0xeb, 0xc6, 0x65, 0x8c, 0x56, 0xa1, 0xb5, 0x93, 0x59, 0x98, 0xa2, 0x50, 0x40, 0xd3, 0xf0,
+	0x56, 0x26, 0x10, 0x2f, 0xfa, 0x32, 0x7e, 0xfb, 0x79, 0x6f, 0xb3, 0xe1, 0xa9, 0x82, 0x7c, 0xb0,
+	0x6b, 0x18, 0xb8, 0x82, 0xa0, 0xeb, 0x5f, 0x10, 0x98, 0x44, 0x52, 0xb4, 0x2b, 0xcf, 0xca, 0x6f,
+	0x6d, 0xed, 0xa0, 0xc9, 0x5a, 0x27, 0xc3, 0xaa, 0x33, 0xef, 0x20, 0xdf, 0x5f, 0x64, 0x83, 0xd0,
+	0xaf, 0xfe, 0x0f, 0x47, 0x96, 0xa4, 0xae, 0x1d, 0x16, 0x2f, 0x2a, 0xea, 0x6f, 0x76, 0x44, 0x52,
+	0xf7, 0xfa, 0xcb, 0x0d, 0x67, 0xab, 0x0d, 0x67, 0xdf, 0x1b, 0xce, 0x5e, 0xb7, 0xdc, 0x5b, 0x6d,
+	0xb9, 0xf7, 0xb9, 0xe5, 0xde, 0x63, 0x3b, 0x49, 0x69, 0xfa, 0x3c, 0x0e, 0x63, 0x54, 0xc2, 0x0d,
+	0xea, 0x30, 0xeb, 0x5e, 0x8b, 0x7c, 0x3f, 0x2e, 0x2d, 0xb4, 0xb4, 0xe3, 0xa3, 0x62, 0x9d, 0x9b,
+	0xdf, 0x01, 0x00, 0xc3, 0x9d, 0xfd, 0x63, 0x7a, 0x01, 0x00, 0x00,
+}
+
+func (m *ValidatorSetParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxValidatorsStep != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidatorsStep))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TargetMaxValidators != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.TargetMaxValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxPowerPercent.Size()
+		i -= size
+		if _, err := m.MaxPowerPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxPowerPercent.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.TargetMaxValidators != 0 {
+		n += 1 + sovStaking(uint64(m.TargetMaxValidators))
+	}
+	if m.MaxValidatorsStep != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidatorsStep))
+	}
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSetParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPowerPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPowerPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetMaxValidators", wireType)
+			}
+			m.TargetMaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetMaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorsStep", wireType)
+			}
+			m.MaxValidatorsStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorsStep |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStaking(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStaking
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStaking
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStaking
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStaking        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStaking          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStaking = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,602 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/staking/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateValidatorSetParams defines a Msg for updating the emergency
+// validator set parameters.
+type MsgUpdateValidatorSetParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the validator set parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params ValidatorSetParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateValidatorSetParams) Reset()         { *m = MsgUpdateValidatorSetParams{} }
+func (m *MsgUpdateValidatorSetParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorSetParams) ProtoMessage()    {}
+func (*MsgUpdateValidatorSetParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7109c9d303d7d8d, []int{0}
+}
+func (m *MsgUpdateValidatorSetParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValidatorSetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorSetParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateValidatorSetParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorSetParams.Merge(m, src)
+}
+func (m *MsgUpdateValidatorSetParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValidatorSetParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorSetParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateValidatorSetParams proto.InternalMessageInfo
+
+func (m *MsgUpdateValidatorSetParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateValidatorSetParams) GetParams() ValidatorSetParams {
+	if m != nil {
+		return m.Params
+	}
+	return ValidatorSetParams{}
+}
+
+// MsgUpdateValidatorSetParamsResponse defines the response structure for
+// executing a MsgUpdateValidatorSetParams message.
+type MsgUpdateValidatorSetParamsResponse struct {
+}
+
+func (m *MsgUpdateValidatorSetParamsResponse) Reset()         { *m = MsgUpdateValidatorSetParamsResponse{} }
+func (m *MsgUpdateValidatorSetParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorSetParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateValidatorSetParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7109c9d303d7d8d, []int{1}
+}
+func (m *MsgUpdateValidatorSetParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValidatorSetParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorSetParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateValidatorSetParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorSetParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateValidatorSetParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValidatorSetParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorSetParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateValidatorSetParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateValidatorSetParams)(nil), "evmos.staking.v1.MsgUpdateValidatorSetParams")
+	proto.RegisterType((*MsgUpdateValidatorSetParamsResponse)(nil), "evmos.staking.v1.MsgUpdateValidatorSetParamsResponse")
+}
+
+func init() { proto.RegisterFile("evmos/staking/v1/tx.proto", fileDescriptor_c7109c9d303d7d8d) }
+
+var fileDescriptor_c7109c9d303d7d8d = []byte{
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x41, 0x4b, 0x02, 0x41,
+	0x14, 0xde, 0x29, 0x12, 0xdc, 0x2e, 0xb5, 0x08, 0xad, 0x1b, 0x4c, 0xa2, 0x05, 0xb2, 0xe0, 0x4e,
+	0x1a, 0x79, 0xe8, 0x96, 0x04, 0x9d, 0x84, 0x50, 0xea, 0xd0, 0x25, 0x46, 0x77, 0x18, 0x97, 0xda,
+	0x9d, 0x65, 0x67, 0x5c, 0xf4, 0x26, 0x1d, 0xbb, 0xd4, 0xcf, 0xe8, 0xe8, 0xa1, 0x1f, 0xe1, 0x51,
+	0x3a, 0x44, 0xa7, 0x08, 0x3d, 0xf8, 0x37, 0xc2, 0x9d, 0x55, 0x2b, 0x49, 0xe8, 0xf2, 0xd8, 0xb7,
+	0xdf, 0x7b, 0xdf, 0xf7, 0xbe, 0x6f, 0xd4, 0x34, 0x09, 0x5d, 0xc6, 0x11, 0x17, 0xf8, 0xd6, 0xf1,
+	0x28, 0x0a, 0x8b, 0x48, 0x74, 0x2c, 0x3f, 0x60, 0x82, 0x69, 0x5b, 0x11, 0x64, 0xc5, 0x90, 0x15,
+	0x16, 0x8d, 0x6d, 0xec, 0x3a, 0x1e, 0x43, 0x51, 0x95, 0x43, 0xc6, 0x4e, 0x93, 0xf1, 0x29, 0x81,
+	0xcb, 0xa3, 0x65, 0x97, 0xd3, 0x18, 0x48, 0x4b, 0xe0, 0x26, 0xea, 0x90, 0x6c, 0x62, 0x08, 0x2e,
+	0x69, 0xce, 0x34, 0x24, 0x9e, 0xa2, 0x8c, 0x32, 0xb9, 0x37, 0xfd, 0x92, 0x7f, 0xb3, 0x6f, 0x40,
+	0xdd, 0xad, 0x72, 0x7a, 0xe9, 0xdb, 0x58, 0x90, 0x2b, 0x7c, 0xe7, 0xd8, 0x58, 0xb0, 0xa0, 0x4e,
+	0xc4, 0x05, 0x0e, 0xb0, 0xcb, 0xb5, 0xb2, 0x9a, 0xc4, 0x6d, 0xd1, 0x62, 0x81, 0x23, 0xba, 0x3a,
+	0xc8, 0x80, 0x7c, 0xb2, 0xa2, 0xbf, 0xbe, 0x14, 0x52, 0xb1, 0xf4, 0xa9, 0x6d, 0x07, 0x84, 0xf3,
+	0xba, 0x08, 0x1c, 0x8f, 0xd6, 0x16, 0xa3, 0xda, 0xb9, 0x9a, 0xf0, 0x23, 0x06, 0x7d, 0x2d, 0x03,
+	0xf2, 0x9b, 0xa5, 0x7d, 0xeb, 0xb7, 0x6f, 0x6b, 0x59, 0xad, 0x92, 0x1c, 0x7c, 0xec, 0x29, 0xcf,
+	0x93, 0xbe, 0x09, 0x6a, 0xf1, 0xfa, 0x49, 0xf9, 0x7e, 0xd2, 0x37, 0x17, 0xc4, 0x0f, 0x93, 0xbe,
+	0x99, 0xfb, 0xe9, 0xf4, 0xfb, 0xf9, 0x73, 0xaa, 0xec, 0x81, 0x9a, 0x5b, 0xe1, 0xab, 0x46, 0xb8,
+	0xcf, 0x3c, 0x4e, 0x4a, 0x8f, 0x40, 0x5d, 0xaf, 0x72, 0xaa, 0xf5, 0x80, 0xaa, 0xff, 0x19, 0x42,
+	0x61, 0xf9, 0xf8, 0x15, 0xdc, 0xc6, 0xf1, 0xbf, 0xc6, 0x67, 0xa7, 0x18, 0x1b, 0xbd, 0xa9, 0xf1,
+	0xca, 0xd9, 0x60, 0x04, 0xc1, 0x70, 0x04, 0xc1, 0xe7, 0x08, 0x82, 0xa7, 0x31, 0x54, 0x86, 0x63,
+	0xa8, 0xbc, 0x8f, 0xa1, 0x72, 0x6d, 0x52, 0x47, 0xb4, 0xda, 0x0d, 0xab, 0xc9, 0x5c, 0x24, 0x23,
+	0x90, 0x35, 0x2c, 0x1d, 0xa2, 0xce, 0x3c, 0x0e, 0xd1, 0xf5, 0x09, 0x6f, 0x24, 0xa2, 0xe7, 0x3d,
+	0xfa, 0x1a, 0x00, 0x6f, 0xe4, 0x34, 0x13, 0x8a, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateValidatorSetParams defines a governance operation for updating the
+	// emergency validator set parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateValidatorSetParams(ctx context.Context, in *MsgUpdateValidatorSetParams, opts ...grpc.CallOption) (*MsgUpdateValidatorSetParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateValidatorSetParams(ctx context.Context, in *MsgUpdateValidatorSetParams, opts ...grpc.CallOption) (*MsgUpdateValidatorSetParamsResponse, error) {
+	out := new(MsgUpdateValidatorSetParamsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.staking.v1.Msg/UpdateValidatorSetParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateValidatorSetParams defines a governance operation for updating the
+	// emergency validator set parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateValidatorSetParams(context.Context, *MsgUpdateValidatorSetParams) (*MsgUpdateValidatorSetParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateValidatorSetParams(ctx context.Context, req *MsgUpdateValidatorSetParams) (*MsgUpdateValidatorSetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorSetParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateValidatorSetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValidatorSetParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValidatorSetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.staking.v1.Msg/UpdateValidatorSetParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValidatorSetParams(ctx, req.(*MsgUpdateValidatorSetParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.staking.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateValidatorSetParams",
+			Handler:    _Msg_UpdateValidatorSetParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/staking/v1/tx.proto",
+}
+
+func (m *MsgUpdateValidatorSetParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorSetParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorSetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorSetParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorSetParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorSetParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateValidatorSetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateValidatorSetParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateValidatorSetParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorSetParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorSetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateValidatorSetParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorSetParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorSetParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)